@@ -199,6 +199,7 @@ type PipelineSpec struct {
 	InputRefs []string `json:"inputRefs"`
 
 	// OutputRefs lists the names (`output.name`) of outputs from this pipeline.
+	// When routes are defined, only the log records matching none of the routes are sent to these outputs.
 	//
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinItems:=1
@@ -213,6 +214,43 @@ type PipelineSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Filters"
 	FilterRefs []string `json:"filterRefs,omitempty"`
+
+	// Routes send the log records matching their tests to their own outputs instead of `outputRefs`.
+	//
+	// Routes are evaluated after all filters are applied.
+	// A record matching several routes is sent to the outputs of each route.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems:=10
+	// +listType:=map
+	// +listMapKey:=name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Routes"
+	Routes []PipelineRoute `json:"routes,omitempty"`
+}
+
+// PipelineRoute sends the log records of a pipeline matching a set of tests to a set of outputs.
+type PipelineRoute struct {
+	// Name of the route
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:="^[a-z][a-z0-9-]*[a-z0-9]$"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Name",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Name string `json:"name"`
+
+	// Tests is an array of tests for the log records to route.
+	// A log record is routed if any test passes. Each test contains a sequence of conditions, all conditions must be true for the test to pass.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tests"
+	Tests []DropTest `json:"tests"`
+
+	// OutputRefs lists the names (`output.name`) of outputs receiving the log records matching the route.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Outputs"
+	OutputRefs []string `json:"outputRefs"`
 }
 
 type LimitSpec struct {
@@ -104,6 +104,9 @@ const (
 	// ReasonValidationFailure is used when validation fails.
 	ReasonValidationFailure = "ValidationFailure"
 
+	// ReasonValidationPending is used when validation waits for a check that has not completed.
+	ReasonValidationPending = "ValidationPending"
+
 	// ReasonUnknownState is used when the operator can not determine the state of the deployment
 	ReasonUnknownState = "UnknownState"
 )
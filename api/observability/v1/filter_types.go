@@ -16,36 +16,60 @@ package v1
 
 // FilterType specifies the type of filter used in a pipeline
 //
-// +kubebuilder:validation:Enum:=openshiftLabels;detectMultilineException;drop;kubeAPIAudit;parse;prune
+// +kubebuilder:validation:Enum:=openshiftLabels;detectMultilineException;dedupe;drop;enrichIP;kubeAPIAudit;kubernetesMetadata;logToMetric;parse;prune;redact;sample;throttle;transform
 type FilterType string
 
 // Filter type constants, must match JSON tags of FilterTypeSpec fields.
 const (
-	FilterTypeDetectMultiline FilterType = "detectMultilineException"
-	FilterTypeDrop            FilterType = "drop"
-	FilterTypeKubeAPIAudit    FilterType = "kubeAPIAudit"
-	FilterTypeOpenshiftLabels FilterType = "openshiftLabels"
-	FilterTypeParse           FilterType = "parse"
-	FilterTypePrune           FilterType = "prune"
+	FilterTypeDedupe             FilterType = "dedupe"
+	FilterTypeDetectMultiline    FilterType = "detectMultilineException"
+	FilterTypeDrop               FilterType = "drop"
+	FilterTypeEnrichIP           FilterType = "enrichIP"
+	FilterTypeKubeAPIAudit       FilterType = "kubeAPIAudit"
+	FilterTypeKubernetesMetadata FilterType = "kubernetesMetadata"
+	FilterTypeLogToMetric        FilterType = "logToMetric"
+	FilterTypeOpenshiftLabels    FilterType = "openshiftLabels"
+	FilterTypeParse              FilterType = "parse"
+	FilterTypePrune              FilterType = "prune"
+	FilterTypeRedact             FilterType = "redact"
+	FilterTypeSample             FilterType = "sample"
+	FilterTypeThrottle           FilterType = "throttle"
+	FilterTypeTransform          FilterType = "transform"
 )
 
 var (
 	FilterTypes = []FilterType{
 		FilterTypeOpenshiftLabels,
 		FilterTypeDetectMultiline,
+		FilterTypeDedupe,
 		FilterTypeDrop,
+		FilterTypeEnrichIP,
 		FilterTypeKubeAPIAudit,
+		FilterTypeKubernetesMetadata,
+		FilterTypeLogToMetric,
 		FilterTypeParse,
 		FilterTypePrune,
+		FilterTypeRedact,
+		FilterTypeSample,
+		FilterTypeThrottle,
+		FilterTypeTransform,
 	}
 )
 
 // FilterSpec defines a filter for log messages.
 //
 // +kubebuilder:validation:XValidation:rule="self.type != 'kubeAPIAudit' || has(self.kubeAPIAudit)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'dedupe' || has(self.dedupe)", message="Additional type specific spec is required for the filter type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'drop' || has(self.drop)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'enrichIP' || has(self.enrichIP)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'kubernetesMetadata' || has(self.kubernetesMetadata)", message="Additional type specific spec is required for the filter type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'prune' || has(self.prune)", message="Additional type specific spec is required for the filter type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'openshiftLabels' || has(self.openshiftLabels)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'logToMetric' || has(self.logToMetric)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'redact' || has(self.redact)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'sample' || has(self.sample)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'throttle' || has(self.throttle)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'transform' || has(self.transform)", message="Additional type specific spec is required for the filter type"
 type FilterSpec struct {
 	// Name used to refer to the filter from a "pipeline".
 	//
@@ -57,12 +81,20 @@ type FilterSpec struct {
 	//
 	// Possible filter types are:
 	//
-	// 1. detectMultilineException - Enables multi-line error detection of container logs. No additional configuration required.
-	// 2. drop - Drop whole log records based on the evaluation of a set of regex tests. See field `drop` for configuration.
-	// 3. kubeAPIAudit - Remove unwanted audit events and reduce event size to create a manageable audit trail. See field `kubeAPIaudit` for configuration.
-	// 4. openshiftLabels - Labels to be applied to log records passing through a pipeline. See field `openshiftLabels` for configuration.
-	// 5. parse - Enables parsing of log entries into structured logs. No additional configuration required.
-	// 6. prune - Prune log record fields to reduce the size of logs flowing into a log store. See field `prune` for configuration.
+	// 1. dedupe - Collapse repeated identical log records into a single record with a count of the suppressed duplicates. See field `dedupe` for configuration.
+	// 2. detectMultilineException - Enables multi-line error detection of container logs. No additional configuration required.
+	// 3. drop - Drop whole log records based on the evaluation of a set of regex tests. See field `drop` for configuration.
+	// 4. enrichIP - Label IP addresses with the names of the network CIDRs containing them. See field `enrichIP` for configuration.
+	// 5. kubeAPIAudit - Remove unwanted audit events and reduce event size to create a manageable audit trail. See field `kubeAPIaudit` for configuration.
+	// 6. kubernetesMetadata - Select the pod annotations and add the owner workload of container logs. See field `kubernetesMetadata` for configuration.
+	// 7. logToMetric - Publish metrics computed from log records on the collector metrics endpoint. See field `logToMetric` for configuration.
+	// 8. openshiftLabels - Labels to be applied to log records passing through a pipeline. See field `openshiftLabels` for configuration.
+	// 9. parse - Enables parsing of log entries into structured logs. See field `parse` for optional configuration.
+	// 10. prune - Prune log record fields to reduce the size of logs flowing into a log store. See field `prune` for configuration.
+	// 11. redact - Mask sensitive values such as credit card numbers, bearer tokens and email addresses. See field `redact` for configuration.
+	// 12. sample - Keep a deterministic fraction of log records. See field `sample` for configuration.
+	// 13. throttle - Rate limit log records per key. See field `throttle` for configuration.
+	// 14. transform - Modify log records using a user-supplied VRL program. See field `transform` for configuration.
 	//
 	// +kubebuilder:validation:Required
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Filter Type"
@@ -72,6 +104,31 @@ type FilterSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Kubernetes API Audit Filter"
 	KubeAPIAudit *KubeAPIAudit `json:"kubeAPIAudit,omitempty"`
 
+	// A kubernetesMetadata filter selects the pod annotations and adds the owner workload of container log records.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Kubernetes Metadata Filter"
+	KubernetesMetadataFilterSpec *KubernetesMetadataFilterSpec `json:"kubernetesMetadata,omitempty"`
+
+	// A dedupe filter collapses the log records with identical values of a set of fields within a window.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Dedupe Filter"
+	DedupeFilterSpec *DedupeFilterSpec `json:"dedupe,omitempty"`
+
+	// An enrichIP filter labels the IP addresses of log records using a table of network CIDRs.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Enrich IP Filter"
+	EnrichIPFilterSpec *EnrichIPFilterSpec `json:"enrichIP,omitempty"`
+
+	// A logToMetric filter publishes metrics computed from the log records passing through the filter.
+	// Log records are not modified.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log to Metric Filter"
+	LogToMetricFilterSpec *LogToMetricFilterSpec `json:"logToMetric,omitempty"`
+
 	// A drop filter applies a sequence of tests to a log record and drops the record if any test passes.
 	// Each test contains a sequence of conditions, all conditions must be true for the test to pass.
 	// A DropTestsSpec contains an array of tests which contains an array of conditions
@@ -92,6 +149,144 @@ type FilterSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Labels"
 	OpenshiftLabels map[string]string `json:"openshiftLabels,omitempty"`
+
+	// A parse filter parses the value of a field of the log record into structured data.
+	// When not set, the message of container logs is parsed as JSON into the `structured` field.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Parse Filter"
+	ParseFilterSpec *ParseFilterSpec `json:"parse,omitempty"`
+
+	// A redact filter replaces sensitive values found in string fields of the log record.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Redact Filter"
+	RedactFilterSpec *RedactFilterSpec `json:"redact,omitempty"`
+
+	// A sample filter keeps a fraction of the log records passing through the filter and drops the rest.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Sample Filter"
+	SampleFilterSpec *SampleFilterSpec `json:"sample,omitempty"`
+
+	// A throttle filter limits the rate of log records passing through the filter for each value of a key.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Throttle Filter"
+	ThrottleFilterSpec *ThrottleFilterSpec `json:"throttle,omitempty"`
+
+	// A transform filter applies a user-supplied VRL program to each log record passing through the filter.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Transform Filter"
+	TransformFilterSpec *TransformFilterSpec `json:"transform,omitempty"`
+}
+
+type DedupeFilterSpec struct {
+	// Fields is an array of dot-delimited field paths whose values define the identity of a log record.
+	// Log records with identical values of all fields are duplicates. Log records without any of the fields are not deduplicated.
+	//
+	// Examples:
+	//
+	//  - `.message`
+	//
+	//  - `.kubernetes.namespace_name`
+	//
+	//  - `.kubernetes.pod_name`
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +kubebuilder:validation:MaxItems:=10
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Identity Fields"
+	Fields []FieldPath `json:"fields"`
+
+	// CacheSize is the maximum number of duplicates of a log record counted within a window.
+	// Once reached, the record is emitted with the count and the next duplicate starts a new window. Defaults to 1000
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=10000
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Cache Size",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	CacheSize int64 `json:"cacheSize,omitempty"`
+
+	// WindowSeconds is the duration of the window during which duplicates of a log record are suppressed.
+	// The first record of the window is emitted at the end of the window with the count of the suppressed duplicates. Defaults to 10 seconds
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=300
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Window Seconds",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	WindowSeconds int64 `json:"windowSeconds,omitempty"`
+}
+
+type EnrichIPFilterSpec struct {
+	// Fields is an array of dot-delimited field paths holding an IP address or an array of IP addresses.
+	// The label of the first address contained in a CIDR of the table is added to the log record.
+	//
+	// Examples:
+	//
+	//  - `.sourceIPs`
+	//
+	//  - `.structured.src_ip`
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +kubebuilder:validation:MaxItems:=10
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="IP Fields"
+	Fields []FieldPath `json:"fields"`
+
+	// CIDRTable references a CSV table in a ConfigMap or Secret key with a header row of `cidr,label`.
+	// Each CIDR is a network address in canonical form and the label of the most specific CIDR containing an address is used.
+	// Changes to the table roll out the collector.
+	//
+	// Example:
+	//
+	//   cidr,label
+	//   10.0.0.0/16,datacenter-east
+	//   10.1.0.0/16,datacenter-west
+	//   0.0.0.0/0,external
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="CIDR Table"
+	CIDRTable ValueReference `json:"cidrTable"`
+
+	// Target is the dot-delimited field path of the label added to the log record. Defaults to `.network_zone`
+	//
+	// The label is available to the drop filter and pipeline routes using the same path.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Target Field"
+	Target FieldPath `json:"target,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule="(has(self.annotations) && size(self.annotations) > 0) || (has(self.includeOwner) && self.includeOwner)", message="at least one of annotations or includeOwner must be defined"
+type KubernetesMetadataFilterSpec struct {
+	// Annotations is an allowlist of pod annotation keys kept in `.kubernetes.annotations` of container log records.
+	// Other annotations are removed. The dots and slashes of the keys are replaced with underscores, like labels.
+	// Annotations are not modified when the list is empty
+	//
+	// Examples:
+	//
+	//  - `openshift.io/scc`
+	//
+	//  - `app.example.com/team`
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems:=50
+	// +listType:=set
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Pod Annotations"
+	Annotations []string `json:"annotations,omitempty"`
+
+	// IncludeOwner adds the kind and name of the top-level workload owning the pod as `.kubernetes.owner.kind`
+	// and `.kubernetes.owner.name` of container log records.
+	// A ReplicaSet created by a Deployment resolves to the Deployment.
+	// The collector does not see the owner of a Job, so a Job named like the Jobs of a CronJob, with a suffix of the
+	// scheduled time as eight digits of minutes since the epoch that is not in the future, resolves to the CronJob.
+	// A Job created with such a name by other means is also reported as a CronJob
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Include Owner Workload",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	IncludeOwner bool `json:"includeOwner,omitempty"`
 }
 
 type DropTest struct {
@@ -192,3 +387,449 @@ type PruneFilterSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Fields to be kept"
 	NotIn []FieldPath `json:"notIn,omitempty"`
 }
+
+// LogMetricType is the type of metric published by the logToMetric filter
+//
+// +kubebuilder:validation:Enum:=counter;gauge;histogram
+type LogMetricType string
+
+const (
+	LogMetricTypeCounter   LogMetricType = "counter"
+	LogMetricTypeGauge     LogMetricType = "gauge"
+	LogMetricTypeHistogram LogMetricType = "histogram"
+)
+
+type LogToMetricFilterSpec struct {
+	// Metrics is an array of metrics computed from the log records.
+	//
+	// Metrics are published on the collector metrics endpoint with the name prefixed by `logcollector_filter_`
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +kubebuilder:validation:MaxItems:=20
+	// +listType:=map
+	// +listMapKey:=name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Metrics"
+	Metrics []LogMetric `json:"metrics"`
+
+	// TagValueLimit is the maximum number of distinct values of each tag.
+	// Once the limit is reached, the tag is removed from metrics with a new value to bound the number of published series.
+	// Defaults to 500
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=5000
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tag Value Limit",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	TagValueLimit int64 `json:"tagValueLimit,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule="self.type == 'counter' || has(self.field)", message="field is required for gauge and histogram metrics"
+type LogMetric struct {
+	// Name of the metric.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:="^[a-zA-Z_][a-zA-Z0-9_]*$"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Metric Name"
+	Name string `json:"name"`
+
+	// Type of the metric.
+	//
+	// Possible types are:
+	//
+	// 1. counter - Counts the log records or, when `field` is set, sums the values of the field
+	//
+	// 2. gauge - Records the last value of `field`
+	//
+	// 3. histogram - Records the distribution of the values of `field`
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Metric Type"
+	Type LogMetricType `json:"type"`
+
+	// Field is a dot-delimited path to a field with a numeric value. Log records where the value of the field can not be
+	// converted to a number are not measured.
+	//
+	// Examples: `.duration_ms`, `.structured.response.size`
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Field Path"
+	Field FieldPath `json:"field,omitempty"`
+
+	// Tests is an array of tests for the log records to measure.
+	// A log record is measured if any test passes. Each test contains a sequence of conditions, all conditions must be true for the test to pass.
+	// When not set, all log records are measured.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tests"
+	Tests []DropTest `json:"tests,omitempty"`
+
+	// Tags are the labels of the metric. The key is the name of the label and the value is a dot-delimited path to
+	// the field whose value is used for the label.
+	//
+	// Example: `namespace: .kubernetes.namespace_name`
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxProperties:=5
+	// +kubebuilder:validation:XValidation:rule="self.all(k, k.matches('^[a-zA-Z_][a-zA-Z0-9_]*$'))", message="tag names must be valid metric label names"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tags"
+	Tags map[string]FieldPath `json:"tags,omitempty"`
+}
+
+// ParseFormat is the format of the values parsed by the parse filter
+//
+// +kubebuilder:validation:Enum:=json;logfmt;keyValue;csv;regex;syslog;cef;commonLog
+type ParseFormat string
+
+const (
+	ParseFormatJSON      ParseFormat = "json"
+	ParseFormatLogfmt    ParseFormat = "logfmt"
+	ParseFormatKeyValue  ParseFormat = "keyValue"
+	ParseFormatCSV       ParseFormat = "csv"
+	ParseFormatRegex     ParseFormat = "regex"
+	ParseFormatSyslog    ParseFormat = "syslog"
+	ParseFormatCEF       ParseFormat = "cef"
+	ParseFormatCommonLog ParseFormat = "commonLog"
+)
+
+// ParseFailurePolicy is the action taken on log records whose value can not be parsed
+//
+// +kubebuilder:validation:Enum:=keep;drop;tag
+type ParseFailurePolicy string
+
+const (
+	// ParseFailurePolicyKeep forwards the record with the raw value unmodified
+	ParseFailurePolicyKeep ParseFailurePolicy = "keep"
+
+	// ParseFailurePolicyDrop drops the record
+	ParseFailurePolicyDrop ParseFailurePolicy = "drop"
+
+	// ParseFailurePolicyTag forwards the record with the reason of the failure in the `parse_error` field
+	ParseFailurePolicyTag ParseFailurePolicy = "tag"
+)
+
+// +kubebuilder:validation:XValidation:rule="self.format != 'csv' || has(self.csv)", message="csv is required for the csv format"
+// +kubebuilder:validation:XValidation:rule="self.format != 'regex' || has(self.regex)", message="regex is required for the regex format"
+type ParseFilterSpec struct {
+	// Format of the parsed value.
+	//
+	// Possible formats are:
+	//
+	// 1. json - A JSON object
+	//
+	// 2. logfmt - Key/value pairs separated by spaces (e.g. `level=info msg="started"`)
+	//
+	// 3. keyValue - Key/value pairs with custom delimiters. See field `keyValue` for configuration
+	//
+	// 4. csv - A row of comma separated values. See field `csv` for configuration
+	//
+	// 5. regex - A regular expression with named capture groups. See field `regex` for configuration
+	//
+	// 6. syslog - A RFC3164 or RFC5424 syslog message
+	//
+	// 7. cef - A Common Event Format message
+	//
+	// 8. commonLog - An Apache or nginx access log in the Common Log Format
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=json
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Format"
+	Format ParseFormat `json:"format,omitempty"`
+
+	// Source is a dot-delimited path to the field with the value to parse. Defaults to `.message`
+	//
+	// Records without the field are not modified.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Source Field"
+	Source FieldPath `json:"source,omitempty"`
+
+	// Target is a dot-delimited path to the field where the parsed data is written. Defaults to `.structured`
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Target Field"
+	Target FieldPath `json:"target,omitempty"`
+
+	// OnFailure is the action taken on log records whose value can not be parsed.
+	//
+	// Possible actions are:
+	//
+	// 1. keep - Forward the record with the raw value unmodified
+	//
+	// 2. drop - Drop the record
+	//
+	// 3. tag - Forward the record with the reason of the failure in the `parse_error` field
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=keep
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="On Failure"
+	OnFailure ParseFailurePolicy `json:"onFailure,omitempty"`
+
+	// KeyValue configures the delimiters of the keyValue format
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key Value Format"
+	KeyValue *ParseKeyValueSpec `json:"keyValue,omitempty"`
+
+	// CSV configures the csv format
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="CSV Format"
+	CSV *ParseCSVSpec `json:"csv,omitempty"`
+
+	// Regex configures the regex format
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Regex Format"
+	Regex *ParseRegexSpec `json:"regex,omitempty"`
+}
+
+type ParseKeyValueSpec struct {
+	// KeyValueDelimiter separates a key from its value. Defaults to `=`
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^[^'\n\r]+$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key Value Delimiter"
+	KeyValueDelimiter string `json:"keyValueDelimiter,omitempty"`
+
+	// FieldDelimiter separates the key/value pairs. Defaults to a space
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^[^'\n\r]+$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Field Delimiter"
+	FieldDelimiter string `json:"fieldDelimiter,omitempty"`
+}
+
+type ParseCSVSpec struct {
+	// Headers is an array of the names of the columns. Values are written to the field of the matching column.
+	// Values of extra columns are discarded.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +kubebuilder:validation:items:Pattern:=`^[^'\n\r]+$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Headers"
+	Headers []string `json:"headers"`
+
+	// Delimiter separates the values. It must be a single ASCII character. Defaults to `,`
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength:=1
+	// +kubebuilder:validation:Pattern:=`^[^'\n\r]$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Delimiter"
+	Delimiter string `json:"delimiter,omitempty"`
+}
+
+type ParseRegexSpec struct {
+	// Pattern is a regular expression with named capture groups. The value of each group is written to the field
+	// with the name of the group.
+	//
+	// Example: `^(?P<level>\w+) (?P<msg>.*)$`
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:=`^[^'\n\r]+$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Pattern"
+	Pattern string `json:"pattern"`
+}
+
+// RedactPatternType is a built-in pattern of sensitive data recognized by the redact filter
+//
+// +kubebuilder:validation:Enum:=bearerToken;creditCard;email
+type RedactPatternType string
+
+const (
+	// RedactPatternBearerToken matches an HTTP bearer token including the `Bearer` scheme
+	RedactPatternBearerToken RedactPatternType = "bearerToken"
+
+	// RedactPatternCreditCard matches a sequence of 13 to 19 digits optionally separated by spaces or dashes
+	RedactPatternCreditCard RedactPatternType = "creditCard"
+
+	// RedactPatternEmail matches an email address
+	RedactPatternEmail RedactPatternType = "email"
+)
+
+// RedactStrategy is the way a redacted value is replaced
+//
+// +kubebuilder:validation:Enum:=mask;hash;partial
+type RedactStrategy string
+
+const (
+	// RedactStrategyMask replaces each match with a fixed string
+	RedactStrategyMask RedactStrategy = "mask"
+
+	// RedactStrategyHash replaces each match with the hex encoded SHA-256 digest of the match
+	RedactStrategyHash RedactStrategy = "hash"
+
+	// RedactStrategyPartial replaces all but the trailing characters of each match with `*`
+	RedactStrategyPartial RedactStrategy = "partial"
+)
+
+// +kubebuilder:validation:XValidation:rule="(has(self.patterns) && size(self.patterns) > 0) || (has(self.regexes) && size(self.regexes) > 0)", message="at least one of patterns or regexes must be defined"
+type RedactFilterSpec struct {
+	// Fields is an array of dot-delimited field paths whose values are redacted.
+	//
+	// Each field path expression must start with a "."
+	//
+	// The path can contain alphanumeric characters and underscores (a-zA-Z0-9_).
+	//
+	// If segments contain characters outside of this range, the segment must be quoted otherwise paths do NOT need to be quoted.
+	//
+	// Only fields with string values are redacted, other values are left unchanged.
+	//
+	// Examples:
+	//
+	//  - `.message`
+	//
+	//  - `.kubernetes.annotations."example.com/token"`
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Fields to be redacted"
+	Fields []FieldPath `json:"fields"`
+
+	// Patterns is an array of built-in patterns of sensitive data to redact.
+	//
+	// Possible patterns are:
+	//
+	// 1. bearerToken - An HTTP bearer token including the `Bearer` scheme
+	//
+	// 2. creditCard - A sequence of 13 to 19 digits optionally separated by spaces or dashes
+	//
+	// 3. email - An email address
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Built-in Patterns"
+	Patterns []RedactPatternType `json:"patterns,omitempty"`
+
+	// Regexes is an array of regular expressions, in addition to patterns, whose matches are redacted.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:items:Pattern:=`^[^'\n\r]*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Custom Regular Expressions"
+	Regexes []string `json:"regexes,omitempty"`
+
+	// Replacement defines how each match is replaced. Defaults to masking each match with `[REDACTED]`
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Replacement"
+	Replacement *RedactReplacement `json:"replacement,omitempty"`
+}
+
+type RedactReplacement struct {
+	// Strategy used to replace each match.
+	//
+	// Possible strategies are:
+	//
+	// 1. mask - Replace the match with the value of `mask`
+	//
+	// 2. hash - Replace the match with the hex encoded SHA-256 digest of the match
+	//
+	// 3. partial - Replace all but the last `visible` characters of the match with `*`
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=mask
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Replacement Strategy"
+	Strategy RedactStrategy `json:"strategy,omitempty"`
+
+	// Mask is the value that replaces each match when the strategy is `mask`. Defaults to `[REDACTED]`
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^[^\n\r]*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Mask"
+	Mask string `json:"mask,omitempty"`
+
+	// Visible is the number of trailing characters of each match left unmasked when the strategy is `partial`. Defaults to 4
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=0
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Visible Characters",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	Visible *int `json:"visible,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule="has(self.rate) != has(self.percent)", message="exactly one of rate or percent must be defined"
+type SampleFilterSpec struct {
+	// Rate keeps one out of every `rate` log records.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Rate",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	Rate *int64 `json:"rate,omitempty"`
+
+	// Percent of log records to keep.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=100
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Percent",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	Percent *int32 `json:"percent,omitempty"`
+
+	// KeyField is a dot-delimited path to a field whose value determines if a log record is kept.
+	// Records with the same value are either all kept or all dropped.
+	// When not set, records are sampled individually.
+	//
+	// Examples: `.kubernetes.pod_name`, `.trace_id`
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key Field"
+	KeyField FieldPath `json:"keyField,omitempty"`
+
+	// Exclude is an array of tests for log records that are never sampled and always kept.
+	// A record is excluded if any test passes. Each test contains a sequence of conditions, all conditions must be true for the test to pass.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Exclusions"
+	Exclude []DropTest `json:"exclude,omitempty"`
+}
+
+type ThrottleFilterSpec struct {
+	// Key is the template of the key used to group log records. Each key value is limited independently.
+	//
+	// The Key can be a combination of static values and field paths encased in single curly brackets `{}`.
+	// Log records missing a referenced field share a single limit.
+	//
+	// Static values can only contain alphanumeric characters along with dashes, underscores, dots and forward slashes.
+	//
+	// Example:
+	//
+	//  1. {.kubernetes.namespace_name}
+	//
+	//  2. {.kubernetes.namespace_name}/{.kubernetes.labels."app.kubernetes.io/name"}
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Key string `json:"key"`
+
+	// Threshold is the maximum number of log records for each key value per window.
+	// Log records that exceed the threshold are dropped.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Threshold",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	Threshold int64 `json:"threshold"`
+
+	// WindowSeconds is the duration of the window over which the threshold applies. Defaults to 1 second
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:default:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Window Seconds",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	WindowSeconds int64 `json:"windowSeconds,omitempty"`
+}
+
+type TransformFilterSpec struct {
+	// Source is the Vector Remap Language (VRL) program applied to each log record.
+	//
+	// The program operates on the log record in the same shape that is forwarded to outputs,
+	// so fields are referenced by their ViaQ path (e.g. `.message`, `.kubernetes.namespace_name`).
+	// The program is checked for syntax errors and compiled by the collector when the filter is validated. The filter
+	// is not valid until the program compiles. Errors that depend on the data of a record are only detected at runtime.
+	//
+	// The fields `.log_type`, `.log_source` and `.message` are required by outputs and must not be removed.
+	//
+	// See https://vector.dev/docs/reference/vrl/ for the language reference.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="VRL Source",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Source string `json:"source"`
+}
@@ -21,7 +21,7 @@ import (
 
 // InputType specifies the type of log input to create.
 //
-// +kubebuilder:validation:Enum:=audit;application;infrastructure;receiver
+// +kubebuilder:validation:Enum:=audit;application;infrastructure;receiver;kafka;hostFile;events
 type InputType string
 
 func (s InputType) String() string {
@@ -37,6 +37,12 @@ const (
 	InputTypeAudit InputType = "audit"
 	// InputTypeReceiver defines a network receiver for receiving logs from non-cluster sources.
 	InputTypeReceiver InputType = "receiver"
+	// InputTypeKafka defines a consumer of logs from the topics of a Kafka cluster.
+	InputTypeKafka InputType = "kafka"
+	// InputTypeHostFile defines a collector of log files written to the host filesystem of the nodes.
+	InputTypeHostFile InputType = "hostFile"
+	// InputTypeEvents defines a collector of the Kubernetes events of the cluster.
+	InputTypeEvents InputType = "events"
 )
 
 var (
@@ -45,6 +51,9 @@ var (
 		InputTypeInfrastructure,
 		InputTypeAudit,
 		InputTypeReceiver,
+		InputTypeKafka,
+		InputTypeHostFile,
+		InputTypeEvents,
 	}
 )
 
@@ -53,6 +62,9 @@ var (
 // +kubebuilder:validation:XValidation:rule="self.type != 'infrastructure' || has(self.infrastructure)", message="Additional type specific spec is required for the input type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'audit' || has(self.audit)", message="Additional type specific spec is required for the input type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'receiver' || has(self.receiver)", message="Additional type specific spec is required for the input type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'kafka' || has(self.kafka)", message="Additional type specific spec is required for the input type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'hostFile' || has(self.hostFile)", message="Additional type specific spec is required for the input type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'events' || has(self.events)", message="Additional type specific spec is required for the input type"
 type InputSpec struct {
 	// Name used to refer to the input of a `pipeline`.
 	//
@@ -91,6 +103,24 @@ type InputSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Receiver"
 	Receiver *ReceiverSpec `json:"receiver,omitempty"`
+
+	// Kafka to consume logs from the topics of a Kafka cluster.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Kafka Consumer"
+	Kafka *KafkaInput `json:"kafka,omitempty"`
+
+	// HostFile to collect log files written to the host filesystem of the nodes.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Host Log Files"
+	HostFile *HostFileInput `json:"hostFile,omitempty"`
+
+	// Events to collect the Kubernetes events of the cluster.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Kubernetes Events"
+	Events *EventsInput `json:"events,omitempty"`
 }
 
 type ContainerInputTuningSpec struct {
@@ -139,6 +169,16 @@ type Application struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Pod Selector",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:selector:core:v1:Pod"}
 	Selector *metav1.LabelSelector `json:"selector,omitempty"`
 
+	// NamespaceSelector for logs from pods in namespaces with matching labels.
+	//
+	// Only messages from pods in namespaces with these labels are collected.
+	//
+	// If absent or empty, logs are collected regardless of namespace labels.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Namespace Selector",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:selector:core:v1:Namespace"}
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
 	// Tuning is the container input tuning spec for this container sources
 	//
 	// +kubebuilder:validation:Optional
@@ -204,7 +244,7 @@ var (
 	}
 )
 
-// InfrastructureInputTuningSpec is the infrastructure input tuning spec, for now available only for container sources
+// InfrastructureInputTuningSpec is the infrastructure input tuning spec for container and node sources
 type InfrastructureInputTuningSpec struct {
 
 	// Container is the input tuning spec for container sources
@@ -212,6 +252,70 @@ type InfrastructureInputTuningSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Input Tuning"
 	Container *ContainerInputTuningSpec `json:"container,omitempty"`
+
+	// Journal is the input tuning spec for the journal of the node source
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Journal Input Tuning"
+	Journal *JournalInputTuningSpec `json:"journal,omitempty"`
+}
+
+// JournalPriority is the priority of a journal entry
+//
+// +kubebuilder:validation:Enum:=emergency;alert;critical;error;warning;notice;info;debug
+type JournalPriority string
+
+const (
+	JournalPriorityEmergency JournalPriority = "emergency"
+	JournalPriorityAlert     JournalPriority = "alert"
+	JournalPriorityCritical  JournalPriority = "critical"
+	JournalPriorityError     JournalPriority = "error"
+	JournalPriorityWarning   JournalPriority = "warning"
+	JournalPriorityNotice    JournalPriority = "notice"
+	JournalPriorityInfo      JournalPriority = "info"
+	JournalPriorityDebug     JournalPriority = "debug"
+)
+
+// JournalInputTuningSpec selects the entries of the journal which are collected. Entries are filtered
+// by the collector when reading the journal
+type JournalInputTuningSpec struct {
+
+	// IncludeUnits is the list of systemd units from which to collect entries.
+	// Unit names without a suffix are presumed to be services (e.g. "kubelet" is "kubelet.service").
+	//
+	// If absent or empty, entries of all units are collected.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:items:Pattern:=`^[a-zA-Z0-9:_.\\@-]+$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Include Units"
+	IncludeUnits []string `json:"includeUnits,omitempty"`
+
+	// ExcludeUnits is the list of systemd units from which entries are not collected.
+	// Unit names without a suffix are presumed to be services (e.g. "crio" is "crio.service").
+	//
+	// Takes precedence over IncludeUnits.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:items:Pattern:=`^[a-zA-Z0-9:_.\\@-]+$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Exclude Units"
+	ExcludeUnits []string `json:"excludeUnits,omitempty"`
+
+	// MinPriority is the lowest priority of the entries which are collected. Entries with a lower
+	// priority (e.g. debug entries when the minimum is info) are not collected.
+	//
+	// If absent, entries of all priorities are collected.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Minimum Priority"
+	MinPriority JournalPriority `json:"minPriority,omitempty"`
+
+	// CurrentBootOnly only collects the entries of the current boot of the node.
+	//
+	// Defaults to true.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Current Boot Only",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	CurrentBootOnly *bool `json:"currentBootOnly,omitempty"`
 }
 
 // Infrastructure enables infrastructure logs.
@@ -226,10 +330,10 @@ type Infrastructure struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Sources"
 	Sources []InfrastructureSource `json:"sources,omitempty"`
 
-	// Tuning is the infrastructure input tuning spec, for now available only for container sources
+	// Tuning is the infrastructure input tuning spec for container and node sources
 	//
 	// +kubebuilder:validation:Optional
-	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Container Input Tuning"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Infrastructure Input Tuning"
 	Tuning *InfrastructureInputTuningSpec `json:"tuning,omitempty"`
 }
 
@@ -278,6 +382,16 @@ type AuditInputTuningSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Ignore Older"
 	IgnoreOlder *metav1.Duration `json:"ignoreOlder,omitempty"`
+
+	// AuditdRecordTypes is the list of auditd record types to collect (e.g. SYSCALL, EXECVE, USER_LOGIN).
+	// Records of other types are not forwarded.
+	//
+	// If absent or empty, records of all types are collected.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:items:Pattern:=`^[A-Z0-9_]+$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Auditd Record Types"
+	AuditdRecordTypes []string `json:"auditdRecordTypes,omitempty"`
 }
 
 // Audit enables audit logs.
@@ -298,33 +412,206 @@ type Audit struct {
 
 // ReceiverType specifies the type of receiver that should be created.
 //
-// +kubebuilder:validation:Enum:=http;syslog
+// +kubebuilder:validation:Enum:=http;otlp;syslog
 type ReceiverType string
 
 const (
 	ReceiverTypeHTTP   ReceiverType = "http"
+	ReceiverTypeOTLP   ReceiverType = "otlp"
 	ReceiverTypeSyslog ReceiverType = "syslog"
 )
 
 var (
 	ReceiverTypes = []ReceiverType{
 		ReceiverTypeHTTP,
+		ReceiverTypeOTLP,
 		ReceiverTypeSyslog,
 	}
 )
 
 type InputTLSSpec TLSSpec
 
+// KafkaInput consumes logs from the topics of a Kafka cluster as a member of a consumer group.
+//
+// Consumed records are application logs (log_type = "application") tagged with the topic, partition and offset
+// they were consumed from.
+type KafkaInput struct {
+	// Brokers specifies the list of broker endpoints of the Kafka cluster.
+	//
+	// The list represents only the initial set used by the collector's Kafka client for the
+	// first connection. Each must be a valid URL with a 'tcp' or 'tls' scheme and include a port number.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Kafka Brokers"
+	Brokers []BrokerURL `json:"brokers"`
+
+	// Topics to consume logs from.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +kubebuilder:validation:items:Pattern:=`^[a-zA-Z0-9._-]+$`
+	// +kubebuilder:validation:items:MaxLength:=249
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Kafka Topics"
+	Topics []string `json:"topics"`
+
+	// GroupId is the consumer group the collector joins.
+	//
+	// The partitions of the topics are distributed among the members of the group. Offsets are committed
+	// to the group so consumption resumes where it stopped.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:=`^[a-zA-Z0-9._-]+$`
+	// +kubebuilder:validation:MaxLength:=255
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Consumer Group",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	GroupId string `json:"groupId"`
+
+	// Authentication sets credentials for authenticating to the brokers.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Authentication Options"
+	Authentication *KafkaAuthentication `json:"authentication,omitempty"`
+
+	// TLS contains settings for controlling options of TLS connections to brokers with a 'tls' scheme.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="TLS Options"
+	TLS *InputTLSSpec `json:"tls,omitempty"`
+}
+
+// HostFileInput collects log files written to the host filesystem of the nodes by agents running outside of
+// containers.
+//
+// Collected records are infrastructure logs (log_type = "infrastructure") tagged with the path of the file
+// they were read from. The collector mounts the directories of the paths read-only from the host.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.ignoreOlder) || duration(self.ignoreOlder) >= duration('1s')",message="must be at least 1 second"
+type HostFileInput struct {
+	// Paths are the glob patterns of the files to collect (e.g. /var/log/acme/*.log).
+	//
+	// Each must be an absolute path under /var/log and may not refer to the container, journal or audit logs
+	// which are collected by the application, infrastructure and audit inputs.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +kubebuilder:validation:items:Pattern:=`^/var/log/[^\s]+$`
+	// +kubebuilder:validation:XValidation:rule="self.all(p, !p.contains('..'))",message="paths may not contain '..'"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="File Paths"
+	Paths []string `json:"paths"`
+
+	// Multiline joins consecutive lines of a file into a single message.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Multiline Rules"
+	Multiline *HostFileMultiline `json:"multiline,omitempty"`
+
+	// IgnoreOlder specifies the maximum duration since the last modification
+	// of a file before the collector ignores it.
+	// The default value is 3600 (1 hour).
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Ignore Older"
+	IgnoreOlder *metav1.Duration `json:"ignoreOlder,omitempty"`
+}
+
+// HostFileMultilineMode specifies how the condition pattern of multiline rules is applied.
+//
+// +kubebuilder:validation:Enum:=continueThrough;continuePast;haltBefore;haltWith
+type HostFileMultilineMode string
+
+const (
+	// HostFileMultilineModeContinueThrough adds all consecutive lines matching the condition pattern to the message
+	HostFileMultilineModeContinueThrough HostFileMultilineMode = "continueThrough"
+
+	// HostFileMultilineModeContinuePast adds all consecutive lines matching the condition pattern and the first line
+	// that does not match to the message
+	HostFileMultilineModeContinuePast HostFileMultilineMode = "continuePast"
+
+	// HostFileMultilineModeHaltBefore adds all lines to the message until a line matches the condition pattern
+	HostFileMultilineModeHaltBefore HostFileMultilineMode = "haltBefore"
+
+	// HostFileMultilineModeHaltWith adds all lines to the message up to and including the first line matching
+	// the condition pattern
+	HostFileMultilineModeHaltWith HostFileMultilineMode = "haltWith"
+)
+
+// HostFileMultiline defines rules to join consecutive lines of a file into a single message.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.timeout) || duration(self.timeout) >= duration('1ms')",message="must be at least 1 millisecond"
+type HostFileMultiline struct {
+	// StartPattern is the regular expression matching the first line of a message.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Start Pattern",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	StartPattern string `json:"startPattern"`
+
+	// ConditionPattern is the regular expression matched against the lines following the first line of a message.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Condition Pattern",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	ConditionPattern string `json:"conditionPattern"`
+
+	// Mode specifies how the condition pattern determines the end of a message.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=continueThrough
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Multiline Mode"
+	Mode HostFileMultilineMode `json:"mode,omitempty"`
+
+	// Timeout is the maximum duration to wait for the next line before the message is flushed.
+	// The default value is 1s.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Timeout"
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// EventType is the type of a Kubernetes event.
+//
+// +kubebuilder:validation:Enum:=Normal;Warning
+type EventType string
+
+const (
+	EventTypeNormal  EventType = "Normal"
+	EventTypeWarning EventType = "Warning"
+)
+
+// EventsInput collects the core/v1 Events of the cluster (e.g. OOMKilled, FailedScheduling, image pull failures).
+//
+// Events are infrastructure logs (log_type = "infrastructure") with the event and its involved object in the
+// kubernetes.event field. A forwarder with an events input is deployed as a single replica deployment and may
+// only define receiver, kafka and events inputs. The events are watched from the API server and the collector
+// resumes from the last forwarded event after a restart.
+type EventsInput struct {
+	// Type of the events to collect. All events are collected when omitted.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Event Type"
+	Type EventType `json:"type,omitempty"`
+}
+
 // ReceiverSpec is a union of input Receiver types.
+//
+// +kubebuilder:validation:XValidation:rule="self.type == 'syslog' || !has(self.syslog)", message="syslog receiver configuration is only supported for the syslog receiver type"
+// +kubebuilder:validation:XValidation:rule="self.type == 'otlp' || !has(self.otlp)", message="otlp receiver configuration is only supported for the otlp receiver type"
+// +kubebuilder:validation:XValidation:rule="!has(self.otlp) || !has(self.otlp.grpcPort) || self.otlp.grpcPort != self.port", message="grpcPort must be different from the receiver port"
+// +kubebuilder:validation:XValidation:rule="!has(self.tls) || !has(self.syslog) || !has(self.syslog.mode) || self.syslog.mode == 'tcp'", message="TLS is only supported for a syslog receiver in tcp mode"
+// +kubebuilder:validation:XValidation:rule="!has(self.authentication) || !has(self.syslog) || !has(self.syslog.mode) || self.syslog.mode == 'tcp'", message="authentication is only supported for a syslog receiver in tcp mode"
+// +kubebuilder:validation:XValidation:rule="!has(self.authentication) || !has(self.authentication.token) || self.type == 'http'", message="token authentication is only supported for the http receiver type"
 type ReceiverSpec struct {
 	// Type of Receiver plugin.
 	//
 	// Supported Receiver types are:
 	//
 	// 1. http
-	//    - Currently only supports kubernetes audit logs (log_type = "audit")
-	// 2. syslog
-	//    - Currently only supports node infrastructure logs (log_type = "infrastructure")
+	//    - Supports kubernetes audit logs (log_type = "audit") or application logs (log_type = "application")
+	//      depending upon the configured format
+	// 2. otlp
+	//    - OpenTelemetry logs (log_type = "application") over OTLP/HTTP, OTLP/gRPC or both
+	// 3. syslog
+	//    - Currently only supports node infrastructure logs (log_type = "infrastructure") over TCP, UDP or both
 	//
 	// +kubebuilder:validation:Required
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Receiver Type"
@@ -350,24 +637,174 @@ type ReceiverSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="HTTP Receiver Configuration"
 	HTTP *HTTPReceiver `json:"http,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Syslog Receiver Configuration"
+	Syslog *SyslogReceiver `json:"syslog,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="OTLP Receiver Configuration"
+	OTLP *OTLPReceiver `json:"otlp,omitempty"`
+
+	// Authentication restricts the clients allowed to send logs to the receiver.
+	//
+	// Anything that can reach the service of the receiver can send logs to it when authentication is not defined.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Receiver Authentication"
+	Authentication *ReceiverAuthentication `json:"authentication,omitempty"`
+}
+
+// ReceiverAuthentication defines how clients of a receiver are authenticated.
+//
+// Requests which fail authentication are rejected and logged by the collector.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.clientCA) || has(self.token)", message="one of clientCA or token is required"
+type ReceiverAuthentication struct {
+	// ClientCA is the certificate authority used to verify client certificates.
+	//
+	// Clients are required to present a certificate signed by this authority (mTLS).
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Client Certificate Authority"
+	ClientCA *ValueReference `json:"clientCA,omitempty"`
+
+	// Token is the secret key of the bearer token clients are required to send in the `Authorization` header.
+	//
+	// Requests are authorized by comparing the token with the value of the secret key.
+	// Only supported by the http receiver type.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Bearer Token"
+	Token *SecretReference `json:"token,omitempty"`
+}
+
+// SyslogReceiverMode defines the transport protocols the syslog receiver listens on.
+//
+// +kubebuilder:validation:Enum:=tcp;udp;both
+type SyslogReceiverMode string
+
+const (
+	SyslogReceiverModeTCP  SyslogReceiverMode = "tcp"
+	SyslogReceiverModeUDP  SyslogReceiverMode = "udp"
+	SyslogReceiverModeBoth SyslogReceiverMode = "both"
+)
+
+// SyslogReceiver receives RFC3164 or RFC5424 syslog messages over the network.
+//
+// The format of each message is detected by the receiver.
+type SyslogReceiver struct {
+	// Mode is the transport protocol the receiver listens on.
+	//
+	// Supported modes are:
+	//
+	// 1. tcp
+	// 2. udp
+	//    - TLS is not supported
+	// 3. both
+	//    - The receiver listens for TCP and UDP on the same port. TLS is not supported
+	//
+	// Defaults to `tcp` when not specified.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Transport Mode"
+	Mode SyslogReceiverMode `json:"mode,omitempty"`
+}
+
+// OTLPReceiverProtocol defines the OTLP transport protocols the OTLP receiver listens on.
+//
+// +kubebuilder:validation:Enum:=http;grpc;both
+type OTLPReceiverProtocol string
+
+const (
+	OTLPReceiverProtocolHTTP OTLPReceiverProtocol = "http"
+	OTLPReceiverProtocolGRPC OTLPReceiverProtocol = "grpc"
+	OTLPReceiverProtocolBoth OTLPReceiverProtocol = "both"
+)
+
+// OTLPReceiver receives OpenTelemetry logs pushed by instrumented workloads.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.protocol) || self.protocol != 'both' || has(self.grpcPort)", message="grpcPort is required when the protocol is both"
+// +kubebuilder:validation:XValidation:rule="!has(self.grpcPort) || (has(self.protocol) && self.protocol == 'both')", message="grpcPort is only supported when the protocol is both"
+type OTLPReceiver struct {
+	// Protocol is the OTLP transport protocol the receiver listens on.
+	//
+	// Supported protocols are:
+	//
+	// 1. http
+	//    - OTLP/HTTP on the receiver port
+	// 2. grpc
+	//    - OTLP/gRPC on the receiver port
+	// 3. both
+	//    - OTLP/HTTP on the receiver port and OTLP/gRPC on the grpcPort
+	//
+	// Defaults to `http` when not specified.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="OTLP Protocol"
+	Protocol OTLPReceiverProtocol `json:"protocol,omitempty"`
+
+	// GRPCPort is the port OTLP/gRPC listens on when the protocol is both. It must be a value between 1024 and 65535
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1024
+	// +kubebuilder:validation:Maximum:=65535
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="gRPC Port",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	GRPCPort int32 `json:"grpcPort,omitempty"`
+
+	// MapKubernetesAttributes maps the `k8s.namespace.name`, `k8s.pod.name`, `k8s.pod.uid`, `k8s.container.name` and
+	// `k8s.node.name` resource attributes of the log records to the kubernetes fields of the records.
+	//
+	// The resource attributes are supplied by the sender and are trusted as is: any client of the receiver can set the
+	// namespace of its logs. The receiver can not be forwarded to LokiStack outputs when enabled since the namespace
+	// identifies the tenant of the logs.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Map Kubernetes Attributes",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	MapKubernetesAttributes bool `json:"mapKubernetesAttributes,omitempty"`
 }
 
 // HTTPReceiverFormat defines the type of log data incoming through the HTTP receiver.
 //
-// +kubebuilder:validation:Enum:=kubeAPIAudit
+// +kubebuilder:validation:Enum:=kubeAPIAudit;json;ndjson;text
 type HTTPReceiverFormat string
 
 const (
 	HTTPReceiverFormatKubeAPIAudit HTTPReceiverFormat = "kubeAPIAudit"
+	HTTPReceiverFormatJSON         HTTPReceiverFormat = "json"
+	HTTPReceiverFormatNDJSON       HTTPReceiverFormat = "ndjson"
+	HTTPReceiverFormatText         HTTPReceiverFormat = "text"
 )
 
 // HTTPReceiver receives encoded logs as a HTTP endpoint.
+//
+// +kubebuilder:validation:XValidation:rule="self.format != 'kubeAPIAudit' || !has(self.logSource)", message="logSource is not supported for the kubeAPIAudit format"
 type HTTPReceiver struct {
 	// Format is the format of incoming log data.
 	//
-	// The only currently supported format is `kubeAPIAudit`.
+	// Supported formats are:
+	//
+	// 1. kubeAPIAudit
+	//    - Kubernetes API audit events (log_type = "audit")
+	// 2. json
+	//    - A JSON object or an array of JSON objects per request (log_type = "application")
+	// 3. ndjson
+	//    - Newline delimited JSON objects (log_type = "application")
+	// 4. text
+	//    - Newline delimited lines of raw text, one record per line (log_type = "application")
 	//
 	// +kubebuilder:validation:Required
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Data Format"
 	Format HTTPReceiverFormat `json:"format"`
+
+	// LogSource is the value of the `log_source` field of records received in the json, ndjson or text formats.
+	//
+	// It may not be one of the log sources reserved by the collector (e.g. container, node, syslog).
+	// Defaults to `http` when not specified.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength:=63
+	// +kubebuilder:validation:Pattern:="^[a-zA-Z0-9][a-zA-Z0-9_.-]*$"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Source"
+	LogSource string `json:"logSource,omitempty"`
 }
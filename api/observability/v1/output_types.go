@@ -19,11 +19,12 @@ import (
 
 	openshiftv1 "github.com/openshift/api/config/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // OutputType is used to define the type of output to be created.
 //
-// +kubebuilder:validation:Enum:=azureLogsIngestion;azureMonitor;cloudwatch;elasticsearch;http;kafka;loki;lokiStack;googleCloudLogging;s3;splunk;syslog;otlp
+// +kubebuilder:validation:Enum:=azureBlob;azureLogsIngestion;azureMonitor;cloudwatch;elasticsearch;http;kafka;loki;lokiStack;googleCloudLogging;googleCloudStorage;s3;splunk;syslog;otlp
 type OutputType string
 
 func (s OutputType) String() string {
@@ -32,11 +33,13 @@ func (s OutputType) String() string {
 
 // Output type constants, must match JSON tags of OutputTypeSpec fields.
 const (
+	OutputTypeAzureBlob          OutputType = "azureBlob"
 	OutputTypeAzureLogsIngestion OutputType = "azureLogsIngestion"
 	OutputTypeAzureMonitor       OutputType = "azureMonitor"
 	OutputTypeCloudwatch         OutputType = "cloudwatch"
 	OutputTypeElasticsearch      OutputType = "elasticsearch"
 	OutputTypeGoogleCloudLogging OutputType = "googleCloudLogging"
+	OutputTypeGoogleCloudStorage OutputType = "googleCloudStorage"
 	OutputTypeHTTP               OutputType = "http"
 	OutputTypeKafka              OutputType = "kafka"
 	OutputTypeLoki               OutputType = "loki"
@@ -50,11 +53,13 @@ const (
 var (
 	// OutputTypes contains all supported output types.
 	OutputTypes = []OutputType{
+		OutputTypeAzureBlob,
 		OutputTypeAzureLogsIngestion,
 		OutputTypeAzureMonitor,
 		OutputTypeCloudwatch,
 		OutputTypeElasticsearch,
 		OutputTypeGoogleCloudLogging,
+		OutputTypeGoogleCloudStorage,
 		OutputTypeHTTP,
 		OutputTypeKafka,
 		OutputTypeLoki,
@@ -68,11 +73,13 @@ var (
 
 // OutputSpec defines a destination for log messages.
 //
+// +kubebuilder:validation:XValidation:rule="self.type != 'azureBlob' || has(self.azureBlob)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'azureLogsIngestion' || has(self.azureLogsIngestion)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'azureMonitor' || has(self.azureMonitor)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'cloudwatch' || has(self.cloudwatch)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'elasticsearch' || has(self.elasticsearch)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'googleCloudLogging' || has(self.googleCloudLogging)", message="Additional type specific spec is required for the output type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'googleCloudStorage' || has(self.googleCloudStorage)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'http' || has(self.http)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'kafka' || has(self.kafka)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'loki' || has(self.loki)", message="Additional type specific spec is required for the output type"
@@ -109,6 +116,12 @@ type OutputSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Rate Limiting"
 	Limit *LimitSpec `json:"rateLimit,omitempty"`
 
+	// AzureBlob configures forwarding log events to Azure Blob Storage containers
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Azure Blob Storage"
+	AzureBlob *AzureBlob `json:"azureBlob,omitempty"`
+
 	// AzureLogsIngestion configures forwarding log events to the Azure Monitor Logs Ingestion API
 	//
 	// +kubebuilder:validation:Optional
@@ -140,6 +153,12 @@ type OutputSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Google Cloud Logging"
 	GoogleCloudLogging *GoogleCloudLogging `json:"googleCloudLogging,omitempty"`
 
+	// GoogleCloudStorage configures forwarding log events to Google Cloud Storage buckets
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Google Cloud Storage"
+	GoogleCloudStorage *GoogleCloudStorage `json:"googleCloudStorage,omitempty"`
+
 	// HTTP configures forwarding log events to an HTTP server
 	//
 	// +kubebuilder:validation:Optional
@@ -382,6 +401,91 @@ type AzureLogsIngestionWorkloadIdentity struct {
 	Token *BearerToken `json:"token"`
 }
 
+// AzureBlobTuningSpec contains tuning options for the Azure Blob Storage output.
+type AzureBlobTuningSpec struct {
+	BaseOutputTuningSpec `json:",inline"`
+
+	// Compression causes data to be compressed before sending over the network.
+	// It is an error if the compression type is not supported by the output.
+	//
+	// Valid values are: gzip, none, snappy, zlib, zstd.
+	//
+	// +kubebuilder:validation:Enum:=gzip;none;snappy;zlib;zstd
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Compression"
+	Compression string `json:"compression,omitempty"`
+}
+
+// AzureBlob provides configuration for the output type `azureBlob`.
+// This output writes batches of log events as blobs to an Azure Blob Storage container.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.payloadKey) || (has(self.encoding) && self.encoding == 'text')", message="payloadKey is only supported with the text encoding"
+type AzureBlob struct {
+	// Authentication sets credentials for authenticating the requests to the storage account.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Authentication Options"
+	Authentication *AzureLogsIngestionAuthentication `json:"authentication"`
+
+	// StorageAccount is the name of the Azure storage account.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:=`^[a-z0-9]{3,24}$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Storage Account",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	StorageAccount string `json:"storageAccount"`
+
+	// Container is the name of the blob container where logs will be stored.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:=`^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Container Name",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Container string `json:"container"`
+
+	// KeyPrefix is a templated string that defines the blob name prefix for log objects.  It is a combination of
+	// static or dynamic values consisting of field paths separated by `||` and ending with a static
+	// fallback value (e.g. logs_{.kubernetes.namespace_name||.hostname||"unknown"}/).
+	//
+	// If the prefix represents a directory, it must end in `/` to act as a directory path.
+	// A trailing `/` (forward slash) is not automatically added.
+	//
+	// Dynamic values are encased in single curly brackets `{}` and MUST end with a static fallback value separated
+	// with `||`. Static values can only contain alphanumeric characters along with dashes, underscores, dots and forward slashes.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key Prefix",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	KeyPrefix string `json:"keyPrefix"`
+
+	// Encoding is the format of the objects written to the container.
+	//
+	// Valid values are: json, ndjson, text. The default is json.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Encoding"
+	Encoding ObjectEncodingType `json:"encoding,omitempty"`
+
+	// PayloadKey specifies the record field written as the line of text when using the text encoding.
+	// The PayloadKey must be a single field path. By default, the `.message` field is written.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Payload Key",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	PayloadKey FieldPath `json:"payloadKey,omitempty"`
+
+	// URL is the custom blob service endpoint URL (e.g. for sovereign clouds or Azurite).
+	// If not specified, the endpoint of the storage account in the Azure public cloud is used.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="isURL(self)", message="invalid URL"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Custom Endpoint URL",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	URL string `json:"url,omitempty"`
+
+	// Tuning specs tuning for the output
+	//
+	// +kubebuilder:validation:Optional
+	// +nullable
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tuning Options"
+	Tuning *AzureBlobTuningSpec `json:"tuning,omitempty"`
+}
+
 // AzureLogsIngestionTuningSpec contains tuning options for the Azure Logs Ingestion output.
 type AzureLogsIngestionTuningSpec struct {
 	BaseOutputTuningSpec `json:",inline"`
@@ -676,6 +780,47 @@ type ElasticsearchTuningSpec struct {
 	Compression string `json:"compression,omitempty"`
 }
 
+// ElasticsearchBulkAction is the action of the bulk requests writing the documents to Elasticsearch.
+//
+// +kubebuilder:validation:Enum:=create;index
+type ElasticsearchBulkAction string
+
+const (
+	// ElasticsearchBulkActionCreate creates new documents and fails for documents with an existing ID
+	ElasticsearchBulkActionCreate ElasticsearchBulkAction = "create"
+
+	// ElasticsearchBulkActionIndex creates new documents and replaces documents with an existing ID
+	ElasticsearchBulkActionIndex ElasticsearchBulkAction = "index"
+)
+
+// ElasticsearchDataStream is the data stream the logs are written to. The name of the data stream is
+// `<type>-<dataset>-<namespace>`.
+//
+// The fields support the same template syntax as the Index.
+type ElasticsearchDataStream struct {
+	// Type is the type of the data stream. Defaults to `logs`.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Data Stream Type",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Type string `json:"type,omitempty"`
+
+	// Dataset is the dataset of the data stream. Defaults to `generic`.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Data Stream Dataset",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Dataset string `json:"dataset,omitempty"`
+
+	// Namespace is the namespace of the data stream. Defaults to `default`.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Data Stream Namespace",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule="has(self.index) || has(self.dataStream)", message="index is required unless dataStream is set"
 type Elasticsearch struct {
 	URLSpec `json:",inline"`
 
@@ -711,9 +856,49 @@ type Elasticsearch struct {
 	//
 	//  3. foo.{.bar.baz||.qux.quux.corge||.grault||"nil"}-waldo.fred{.plugh||"none"}
 	//
+	// The Index is required unless DataStream is set.
+	//
+	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Index",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
-	Index string `json:"index"`
+	Index string `json:"index,omitempty"`
+
+	// DataStream writes the logs to a data stream instead of the Index. Data streams require Elasticsearch version 7 or later
+	// and only accept the create bulk action.
+	//
+	// +kubebuilder:validation:Optional
+	// +nullable
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Data Stream"
+	DataStream *ElasticsearchDataStream `json:"dataStream,omitempty"`
+
+	// BulkAction is the action used to write the documents: create or index. Defaults to create.
+	//
+	// With a DocumentID the create action drops documents which were already written, and the index action replaces them.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Bulk Action"
+	BulkAction ElasticsearchBulkAction `json:"bulkAction,omitempty"`
+
+	// Pipeline is the name of the ingest pipeline applied to the documents.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^[a-zA-Z0-9_.-]+$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Ingest Pipeline",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Pipeline string `json:"pipeline,omitempty"`
+
+	// DocumentID is the ID of the documents. This supports the same template syntax as the Index.
+	//
+	// Documents are given a unique ID by Elasticsearch when not specified. A DocumentID derived from fields identifying the
+	// log record avoids duplicate documents when records are sent again after a retry or a restart of the collector,
+	// for example with the AtLeastOnce delivery mode. The template must identify a log record uniquely: documents with the
+	// ID of an existing document are rejected with a 409 Conflict by the create action and overwrite it with the index action.
+	//
+	// Example: {.kubernetes.pod_id||"none"}-{.kubernetes.container_name||"none"}-{.timestamp||"none"}
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Document ID",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	DocumentID string `json:"documentId,omitempty"`
 
 	// Version specifies the API version of Elasticsearch to be used. Must be one of: 6-8
 	// The value of '8' should be used when forwarding to Elasticsearch version v8 or greater.
@@ -809,6 +994,87 @@ type GoogleCloudLoggingId struct {
 	Value string `json:"value"`
 }
 
+// GoogleCloudStorageTuningSpec contains tuning options for the Google Cloud Storage output.
+type GoogleCloudStorageTuningSpec struct {
+	BaseOutputTuningSpec `json:",inline"`
+
+	// Compression causes data to be compressed before sending over the network.
+	// It is an error if the compression type is not supported by the output.
+	//
+	// Valid values are: gzip, none, snappy, zlib, zstd.
+	//
+	// +kubebuilder:validation:Enum:=gzip;none;snappy;zlib;zstd
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Compression"
+	Compression string `json:"compression,omitempty"`
+}
+
+// GoogleCloudStorage provides configuration for the output type `googleCloudStorage`.
+// This output writes batches of log events as objects to a Google Cloud Storage bucket.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.payloadKey) || (has(self.encoding) && self.encoding == 'text')", message="payloadKey is only supported with the text encoding"
+type GoogleCloudStorage struct {
+	// Authentication sets credentials for authenticating the requests.
+	// The credentials of the environment of the collector are used when not set.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Authentication Options"
+	Authentication *GoogleCloudLoggingAuthentication `json:"authentication,omitempty"`
+
+	// Bucket specifies the GCS bucket name where logs will be stored.
+	//
+	// String name absent leading `gs://` or trailing `/`
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:=`^[a-z0-9][a-z0-9._-]{1,220}[a-z0-9]$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="GCS Bucket Name",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Bucket string `json:"bucket"`
+
+	// KeyPrefix is a templated string that defines the object name prefix for log objects.  It is a combination of
+	// static or dynamic values consisting of field paths separated by `||` and ending with a static
+	// fallback value (e.g. logs_{.kubernetes.namespace_name||.hostname||"unknown"}/).
+	//
+	// If the prefix represents a directory, it must end in `/` to act as a directory path.
+	// A trailing `/` (forward slash) is not automatically added.
+	//
+	// Dynamic values are encased in single curly brackets `{}` and MUST end with a static fallback value separated
+	// with `||`. Static values can only contain alphanumeric characters along with dashes, underscores, dots and forward slashes.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key Prefix",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	KeyPrefix string `json:"keyPrefix"`
+
+	// Encoding is the format of the objects written to the bucket.
+	//
+	// Valid values are: json, ndjson, text. The default is json.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Encoding"
+	Encoding ObjectEncodingType `json:"encoding,omitempty"`
+
+	// PayloadKey specifies the record field written as the line of text when using the text encoding.
+	// The PayloadKey must be a single field path. By default, the `.message` field is written.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Payload Key",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	PayloadKey FieldPath `json:"payloadKey,omitempty"`
+
+	// URL is the custom Cloud Storage endpoint URL (e.g. for Private Service Connect).
+	// If not specified, the default Google Cloud Storage endpoint will be used.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="isURL(self)", message="invalid URL"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Custom Endpoint URL",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	URL string `json:"url,omitempty"`
+
+	// Tuning specs tuning for the output
+	//
+	// +kubebuilder:validation:Optional
+	// +nullable
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tuning Options"
+	Tuning *GoogleCloudStorageTuningSpec `json:"tuning,omitempty"`
+}
+
 // GoogleCloudLoggingIdType specifies the type of the provided ID value.
 //
 // +kubebuilder:validation:Enum:=billingAccount;folder;project;organization
@@ -914,6 +1180,19 @@ type KafkaTuningSpec struct {
 	// +kubebuilder:validation:Enum:=none;snappy;zstd;lz4
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Compression"
 	Compression string `json:"compression,omitempty"`
+
+	// LibrdKafkaOptions are options passed to the librdkafka producer of the collector.
+	//
+	// Only options tuning the batching and acknowledgement of the producer are allowed:
+	// acks, linger.ms, batch.size, batch.num.messages, queue.buffering.max.messages, queue.buffering.max.kbytes and
+	// request.timeout.ms. The values of acks are all, -1 and 1, and must be all or -1 when the delivery mode is
+	// AtLeastOnce. The other options take numbers within the ranges of librdkafka: linger.ms from 0 to 900000, which
+	// may be a fraction, batch.num.messages from 1 to 1000000, request.timeout.ms from 1 to 900000, batch.size and
+	// queue.buffering.max.kbytes from 1 to 2147483647 and queue.buffering.max.messages from 0 to 2147483647.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="librdkafka Options"
+	LibrdKafkaOptions map[string]string `json:"librdKafkaOptions,omitempty"`
 }
 
 // KafkaAuthentication contains configuration for authenticating requests to a Kafka output.
@@ -940,9 +1219,48 @@ type SASLAuthentication struct {
 
 	// Mechanism sets the SASL mechanism to use.
 	//
+	// The mechanism is OAUTHBEARER when OAuth is set. The AWS_MSK_IAM mechanism of Amazon MSK is not supported,
+	// use SCRAM-SHA-512 or TLS client authentication with Amazon MSK.
+	//
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="SASL Mechanism",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
 	Mechanism string `json:"mechanism,omitempty"`
+
+	// OAuth configures the OAUTHBEARER mechanism with tokens fetched from a token endpoint using the
+	// client credentials grant. This is only supported by Kafka outputs.
+	//
+	// +kubebuilder:validation:Optional
+	// +nullable
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="OAuth Options"
+	OAuth *SASLOAuthentication `json:"oauth,omitempty"`
+}
+
+// SASLOAuthentication contains the client credentials used to fetch OAuth tokens for SASL/OAUTHBEARER.
+type SASLOAuthentication struct {
+	// TokenURL is the URL of the OAuth token endpoint.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="isURL(self)", message="invalid URL"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Token URL",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	TokenURL string `json:"tokenURL"`
+
+	// ClientID points to the secret containing the client ID.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Secret with Client ID"
+	ClientID *SecretReference `json:"clientId"`
+
+	// ClientSecret points to the secret containing the client secret.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Secret with Client Secret"
+	ClientSecret *SecretReference `json:"clientSecret"`
+
+	// Scope is the scope requested for the token.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Scope",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Scope string `json:"scope,omitempty"`
 }
 
 // Kafka provides optional extra properties for `type: kafka`
@@ -992,6 +1310,27 @@ type Kafka struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Kafka Topic",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
 	Topic string `json:"topic,omitempty"`
 
+	// Key specifies the key of the records sent to Kafka. Records with the same key are sent to the same partition
+	// of the topic in order. The records are distributed over the partitions when not specified.
+	//
+	// The Key is a template with the same syntax as the Topic.
+	//
+	// Example: {.kubernetes.namespace_name||"none"}/{.kubernetes.pod_name||"none"}
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Kafka Key",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Key string `json:"key,omitempty"`
+
+	// Headers maps the names of the headers added to the records sent to Kafka to the paths of the fields of
+	// the log record providing their values. Headers of fields which do not exist are not added.
+	//
+	// Example: {"namespace": ".kubernetes.namespace_name"}
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Kafka Headers"
+	Headers map[string]FieldPath `json:"headers,omitempty"`
+
 	// Brokers specifies the list of broker endpoints of a Kafka cluster.
 	//
 	// The list represents only the initial set used by the collector's Kafka client for the
@@ -1664,9 +2003,59 @@ type S3TuningSpec struct {
 	// +kubebuilder:validation:Enum:=gzip;none;snappy;zlib;zstd
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Compression"
 	Compression string `json:"compression,omitempty"`
+
+	// MaxEvents limits the number of log records written to a single object.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Batch Max Events",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	MaxEvents *int64 `json:"maxEvents,omitempty"`
+
+	// BatchTimeout is the maximum time log records are batched before they are written as an object,
+	// regardless of the size of the batch.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Batch Timeout"
+	BatchTimeout *metav1.Duration `json:"batchTimeout,omitempty"`
 }
 
+// ObjectEncodingType is the format of the objects written by the object storage outputs (e.g. s3)
+//
+// +kubebuilder:validation:Enum:=json;ndjson;text
+type ObjectEncodingType string
+
+const (
+	// ObjectEncodingTypeJSON writes each log record as a JSON object, one per line, with the default extension and
+	// content type of the output
+	ObjectEncodingTypeJSON ObjectEncodingType = "json"
+
+	// ObjectEncodingTypeNDJSON writes the same content as ObjectEncodingTypeJSON with the `.ndjson` extension and the
+	// `application/x-ndjson` content type, which are understood by query engines reading the bucket (e.g. Athena)
+	ObjectEncodingTypeNDJSON ObjectEncodingType = "ndjson"
+
+	// ObjectEncodingTypeText writes the value of the payload key of each log record as a line of plain text
+	ObjectEncodingTypeText ObjectEncodingType = "text"
+)
+
+// S3KeyPartitioning adds a date based partition to the key prefix of the objects
+//
+// +kubebuilder:validation:Enum:=none;daily;hourly
+type S3KeyPartitioning string
+
+const (
+	// S3KeyPartitioningNone does not add a partition to the key prefix
+	S3KeyPartitioningNone S3KeyPartitioning = "none"
+
+	// S3KeyPartitioningDaily appends `year=YYYY/month=MM/day=DD/` to the key prefix
+	S3KeyPartitioningDaily S3KeyPartitioning = "daily"
+
+	// S3KeyPartitioningHourly appends `year=YYYY/month=MM/day=DD/hour=HH/` to the key prefix
+	S3KeyPartitioningHourly S3KeyPartitioning = "hourly"
+)
+
 // S3 provides configuration for the output type `s3`
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.payloadKey) || (has(self.encoding) && self.encoding == 'text')", message="payloadKey is only supported with the text encoding"
 type S3 struct {
 	// Authentication sets credentials for authenticating the requests.
 	//
@@ -1717,6 +2106,38 @@ type S3 struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key Prefix",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
 	KeyPrefix string `json:"keyPrefix,omitempty"`
 
+	// KeyPartitioning appends a partition derived from the timestamp of the log record to the key prefix
+	// (e.g. `year=2025/month=01/day=31/hour=13/`) so query engines can prune objects by date.
+	// The key prefix should end with a `/` when a partitioning is used.
+	//
+	// Valid values are: none, daily, hourly. The default is none.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key Partitioning"
+	KeyPartitioning S3KeyPartitioning `json:"keyPartitioning,omitempty"`
+
+	// Encoding is the format of the objects written to the bucket.
+	//
+	// Valid values are: json, ndjson, text. The default is json.
+	// Both json and ndjson write one JSON object per log record and line. They only differ by the extension and
+	// content type of the objects: `.log` and `text/x-log` for json, `.ndjson` and `application/x-ndjson` for ndjson.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Encoding"
+	Encoding ObjectEncodingType `json:"encoding,omitempty"`
+
+	// PayloadKey specifies the record field written as the line of text when using the text encoding.
+	// The PayloadKey must be a single field path. The value is converted to a string and objects or arrays
+	// are written as JSON.
+	//
+	// By default, the `.message` field is written.
+	//
+	// Examples: `.message`, `.kubernetes.labels."foo-bar/baz"`
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Payload Key",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	PayloadKey FieldPath `json:"payloadKey,omitempty"`
+
 	// URL is the custom S3-compatible endpoint URL.
 	// If not specified, the default AWS S3 endpoint will be used.
 	// This is useful for S3-compatible services like MinIO, Ceph Object Gateway, or Dell EMC ECS.
@@ -22,6 +22,11 @@ func (in *Application) DeepCopyInto(out *Application) {
 		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Tuning != nil {
 		in, out := &in.Tuning, &out.Tuning
 		*out = new(ContainerInputTuningSpec)
@@ -82,6 +87,11 @@ func (in *AuditInputTuningSpec) DeepCopyInto(out *AuditInputTuningSpec) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.AuditdRecordTypes != nil {
+		in, out := &in.AuditdRecordTypes, &out.AuditdRecordTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditInputTuningSpec.
@@ -174,6 +184,47 @@ func (in *AwsRole) DeepCopy() *AwsRole {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureBlob) DeepCopyInto(out *AzureBlob) {
+	*out = *in
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(AzureLogsIngestionAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tuning != nil {
+		in, out := &in.Tuning, &out.Tuning
+		*out = new(AzureBlobTuningSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureBlob.
+func (in *AzureBlob) DeepCopy() *AzureBlob {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureBlob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureBlobTuningSpec) DeepCopyInto(out *AzureBlobTuningSpec) {
+	*out = *in
+	in.BaseOutputTuningSpec.DeepCopyInto(&out.BaseOutputTuningSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureBlobTuningSpec.
+func (in *AzureBlobTuningSpec) DeepCopy() *AzureBlobTuningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureBlobTuningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureLogsIngestion) DeepCopyInto(out *AzureLogsIngestion) {
 	*out = *in
@@ -669,6 +720,26 @@ func (in *ContainerInputTuningSpec) DeepCopy() *ContainerInputTuningSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DedupeFilterSpec) DeepCopyInto(out *DedupeFilterSpec) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]FieldPath, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DedupeFilterSpec.
+func (in *DedupeFilterSpec) DeepCopy() *DedupeFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DedupeFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DropCondition) DeepCopyInto(out *DropCondition) {
 	*out = *in
@@ -718,6 +789,11 @@ func (in *Elasticsearch) DeepCopyInto(out *Elasticsearch) {
 		*out = new(ElasticsearchTuningSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DataStream != nil {
+		in, out := &in.DataStream, &out.DataStream
+		*out = new(ElasticsearchDataStream)
+		**out = **in
+	}
 	if in.Headers != nil {
 		in, out := &in.Headers, &out.Headers
 		*out = make(map[string]string, len(*in))
@@ -737,6 +813,21 @@ func (in *Elasticsearch) DeepCopy() *Elasticsearch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticsearchDataStream) DeepCopyInto(out *ElasticsearchDataStream) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchDataStream.
+func (in *ElasticsearchDataStream) DeepCopy() *ElasticsearchDataStream {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticsearchDataStream)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ElasticsearchTuningSpec) DeepCopyInto(out *ElasticsearchTuningSpec) {
 	*out = *in
@@ -753,6 +844,42 @@ func (in *ElasticsearchTuningSpec) DeepCopy() *ElasticsearchTuningSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnrichIPFilterSpec) DeepCopyInto(out *EnrichIPFilterSpec) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]FieldPath, len(*in))
+		copy(*out, *in)
+	}
+	out.CIDRTable = in.CIDRTable
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnrichIPFilterSpec.
+func (in *EnrichIPFilterSpec) DeepCopy() *EnrichIPFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EnrichIPFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventsInput) DeepCopyInto(out *EventsInput) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventsInput.
+func (in *EventsInput) DeepCopy() *EventsInput {
+	if in == nil {
+		return nil
+	}
+	out := new(EventsInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FilterSpec) DeepCopyInto(out *FilterSpec) {
 	*out = *in
@@ -761,6 +888,26 @@ func (in *FilterSpec) DeepCopyInto(out *FilterSpec) {
 		*out = new(KubeAPIAudit)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KubernetesMetadataFilterSpec != nil {
+		in, out := &in.KubernetesMetadataFilterSpec, &out.KubernetesMetadataFilterSpec
+		*out = new(KubernetesMetadataFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DedupeFilterSpec != nil {
+		in, out := &in.DedupeFilterSpec, &out.DedupeFilterSpec
+		*out = new(DedupeFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnrichIPFilterSpec != nil {
+		in, out := &in.EnrichIPFilterSpec, &out.EnrichIPFilterSpec
+		*out = new(EnrichIPFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LogToMetricFilterSpec != nil {
+		in, out := &in.LogToMetricFilterSpec, &out.LogToMetricFilterSpec
+		*out = new(LogToMetricFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DropTestsSpec != nil {
 		in, out := &in.DropTestsSpec, &out.DropTestsSpec
 		*out = make([]DropTest, len(*in))
@@ -780,6 +927,31 @@ func (in *FilterSpec) DeepCopyInto(out *FilterSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.ParseFilterSpec != nil {
+		in, out := &in.ParseFilterSpec, &out.ParseFilterSpec
+		*out = new(ParseFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RedactFilterSpec != nil {
+		in, out := &in.RedactFilterSpec, &out.RedactFilterSpec
+		*out = new(RedactFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SampleFilterSpec != nil {
+		in, out := &in.SampleFilterSpec, &out.SampleFilterSpec
+		*out = new(SampleFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ThrottleFilterSpec != nil {
+		in, out := &in.ThrottleFilterSpec, &out.ThrottleFilterSpec
+		*out = new(ThrottleFilterSpec)
+		**out = **in
+	}
+	if in.TransformFilterSpec != nil {
+		in, out := &in.TransformFilterSpec, &out.TransformFilterSpec
+		*out = new(TransformFilterSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterSpec.
@@ -874,6 +1046,47 @@ func (in *GoogleCloudLoggingTuningSpec) DeepCopy() *GoogleCloudLoggingTuningSpec
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudStorage) DeepCopyInto(out *GoogleCloudStorage) {
+	*out = *in
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(GoogleCloudLoggingAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tuning != nil {
+		in, out := &in.Tuning, &out.Tuning
+		*out = new(GoogleCloudStorageTuningSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudStorage.
+func (in *GoogleCloudStorage) DeepCopy() *GoogleCloudStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudStorageTuningSpec) DeepCopyInto(out *GoogleCloudStorageTuningSpec) {
+	*out = *in
+	in.BaseOutputTuningSpec.DeepCopyInto(&out.BaseOutputTuningSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudStorageTuningSpec.
+func (in *GoogleCloudStorageTuningSpec) DeepCopy() *GoogleCloudStorageTuningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudStorageTuningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTP) DeepCopyInto(out *HTTP) {
 	*out = *in
@@ -968,6 +1181,56 @@ func (in *HTTPTuningSpec) DeepCopy() *HTTPTuningSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostFileInput) DeepCopyInto(out *HostFileInput) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Multiline != nil {
+		in, out := &in.Multiline, &out.Multiline
+		*out = new(HostFileMultiline)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IgnoreOlder != nil {
+		in, out := &in.IgnoreOlder, &out.IgnoreOlder
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostFileInput.
+func (in *HostFileInput) DeepCopy() *HostFileInput {
+	if in == nil {
+		return nil
+	}
+	out := new(HostFileInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostFileMultiline) DeepCopyInto(out *HostFileMultiline) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostFileMultiline.
+func (in *HostFileMultiline) DeepCopy() *HostFileMultiline {
+	if in == nil {
+		return nil
+	}
+	out := new(HostFileMultiline)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Infrastructure) DeepCopyInto(out *Infrastructure) {
 	*out = *in
@@ -1001,6 +1264,11 @@ func (in *InfrastructureInputTuningSpec) DeepCopyInto(out *InfrastructureInputTu
 		*out = new(ContainerInputTuningSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Journal != nil {
+		in, out := &in.Journal, &out.Journal
+		*out = new(JournalInputTuningSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureInputTuningSpec.
@@ -1036,6 +1304,21 @@ func (in *InputSpec) DeepCopyInto(out *InputSpec) {
 		*out = new(ReceiverSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaInput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HostFile != nil {
+		in, out := &in.HostFile, &out.HostFile
+		*out = new(HostFileInput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = new(EventsInput)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InputSpec.
@@ -1083,6 +1366,36 @@ func (in *InputTLSSpec) DeepCopy() *InputTLSSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JournalInputTuningSpec) DeepCopyInto(out *JournalInputTuningSpec) {
+	*out = *in
+	if in.IncludeUnits != nil {
+		in, out := &in.IncludeUnits, &out.IncludeUnits
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeUnits != nil {
+		in, out := &in.ExcludeUnits, &out.ExcludeUnits
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CurrentBootOnly != nil {
+		in, out := &in.CurrentBootOnly, &out.CurrentBootOnly
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JournalInputTuningSpec.
+func (in *JournalInputTuningSpec) DeepCopy() *JournalInputTuningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JournalInputTuningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Kafka) DeepCopyInto(out *Kafka) {
 	*out = *in
@@ -1096,6 +1409,13 @@ func (in *Kafka) DeepCopyInto(out *Kafka) {
 		*out = new(KafkaTuningSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]FieldPath, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.Brokers != nil {
 		in, out := &in.Brokers, &out.Brokers
 		*out = make([]BrokerURL, len(*in))
@@ -1133,6 +1453,41 @@ func (in *KafkaAuthentication) DeepCopy() *KafkaAuthentication {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaInput) DeepCopyInto(out *KafkaInput) {
+	*out = *in
+	if in.Brokers != nil {
+		in, out := &in.Brokers, &out.Brokers
+		*out = make([]BrokerURL, len(*in))
+		copy(*out, *in)
+	}
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(KafkaAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(InputTLSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaInput.
+func (in *KafkaInput) DeepCopy() *KafkaInput {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KafkaTuningSpec) DeepCopyInto(out *KafkaTuningSpec) {
 	*out = *in
@@ -1141,6 +1496,13 @@ func (in *KafkaTuningSpec) DeepCopyInto(out *KafkaTuningSpec) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.LibrdKafkaOptions != nil {
+		in, out := &in.LibrdKafkaOptions, &out.LibrdKafkaOptions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTuningSpec.
@@ -1190,26 +1552,97 @@ func (in *KubeAPIAudit) DeepCopy() *KubeAPIAudit {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LimitSpec) DeepCopyInto(out *LimitSpec) {
+func (in *KubernetesMetadataFilterSpec) DeepCopyInto(out *KubernetesMetadataFilterSpec) {
 	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LimitSpec.
-func (in *LimitSpec) DeepCopy() *LimitSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesMetadataFilterSpec.
+func (in *KubernetesMetadataFilterSpec) DeepCopy() *KubernetesMetadataFilterSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LimitSpec)
+	out := new(KubernetesMetadataFilterSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Loki) DeepCopyInto(out *Loki) {
+func (in *LimitSpec) DeepCopyInto(out *LimitSpec) {
 	*out = *in
-	if in.Authentication != nil {
-		in, out := &in.Authentication, &out.Authentication
-		*out = new(HTTPAuthentication)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LimitSpec.
+func (in *LimitSpec) DeepCopy() *LimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogMetric) DeepCopyInto(out *LogMetric) {
+	*out = *in
+	if in.Tests != nil {
+		in, out := &in.Tests, &out.Tests
+		*out = make([]DropTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]FieldPath, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogMetric.
+func (in *LogMetric) DeepCopy() *LogMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(LogMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogToMetricFilterSpec) DeepCopyInto(out *LogToMetricFilterSpec) {
+	*out = *in
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]LogMetric, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogToMetricFilterSpec.
+func (in *LogToMetricFilterSpec) DeepCopy() *LogToMetricFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LogToMetricFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Loki) DeepCopyInto(out *Loki) {
+	*out = *in
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(HTTPAuthentication)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Tuning != nil {
@@ -1427,6 +1860,21 @@ func (in *OTLP) DeepCopy() *OTLP {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPReceiver) DeepCopyInto(out *OTLPReceiver) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPReceiver.
+func (in *OTLPReceiver) DeepCopy() *OTLPReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OTLPTuningSpec) DeepCopyInto(out *OTLPTuningSpec) {
 	*out = *in
@@ -1456,6 +1904,11 @@ func (in *OutputSpec) DeepCopyInto(out *OutputSpec) {
 		*out = new(LimitSpec)
 		**out = **in
 	}
+	if in.AzureBlob != nil {
+		in, out := &in.AzureBlob, &out.AzureBlob
+		*out = new(AzureBlob)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AzureLogsIngestion != nil {
 		in, out := &in.AzureLogsIngestion, &out.AzureLogsIngestion
 		*out = new(AzureLogsIngestion)
@@ -1481,6 +1934,11 @@ func (in *OutputSpec) DeepCopyInto(out *OutputSpec) {
 		*out = new(GoogleCloudLogging)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GoogleCloudStorage != nil {
+		in, out := &in.GoogleCloudStorage, &out.GoogleCloudStorage
+		*out = new(GoogleCloudStorage)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.HTTP != nil {
 		in, out := &in.HTTP, &out.HTTP
 		*out = new(HTTP)
@@ -1554,6 +2012,113 @@ func (in *OutputTLSSpec) DeepCopy() *OutputTLSSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParseCSVSpec) DeepCopyInto(out *ParseCSVSpec) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParseCSVSpec.
+func (in *ParseCSVSpec) DeepCopy() *ParseCSVSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ParseCSVSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParseFilterSpec) DeepCopyInto(out *ParseFilterSpec) {
+	*out = *in
+	if in.KeyValue != nil {
+		in, out := &in.KeyValue, &out.KeyValue
+		*out = new(ParseKeyValueSpec)
+		**out = **in
+	}
+	if in.CSV != nil {
+		in, out := &in.CSV, &out.CSV
+		*out = new(ParseCSVSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Regex != nil {
+		in, out := &in.Regex, &out.Regex
+		*out = new(ParseRegexSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParseFilterSpec.
+func (in *ParseFilterSpec) DeepCopy() *ParseFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ParseFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParseKeyValueSpec) DeepCopyInto(out *ParseKeyValueSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParseKeyValueSpec.
+func (in *ParseKeyValueSpec) DeepCopy() *ParseKeyValueSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ParseKeyValueSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParseRegexSpec) DeepCopyInto(out *ParseRegexSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParseRegexSpec.
+func (in *ParseRegexSpec) DeepCopy() *ParseRegexSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ParseRegexSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineRoute) DeepCopyInto(out *PipelineRoute) {
+	*out = *in
+	if in.Tests != nil {
+		in, out := &in.Tests, &out.Tests
+		*out = make([]DropTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OutputRefs != nil {
+		in, out := &in.OutputRefs, &out.OutputRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineRoute.
+func (in *PipelineRoute) DeepCopy() *PipelineRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PipelineSpec) DeepCopyInto(out *PipelineSpec) {
 	*out = *in
@@ -1572,6 +2137,13 @@ func (in *PipelineSpec) DeepCopyInto(out *PipelineSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]PipelineRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineSpec.
@@ -1609,6 +2181,31 @@ func (in *PruneFilterSpec) DeepCopy() *PruneFilterSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReceiverAuthentication) DeepCopyInto(out *ReceiverAuthentication) {
+	*out = *in
+	if in.ClientCA != nil {
+		in, out := &in.ClientCA, &out.ClientCA
+		*out = new(ValueReference)
+		**out = **in
+	}
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverAuthentication.
+func (in *ReceiverAuthentication) DeepCopy() *ReceiverAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(ReceiverAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReceiverSpec) DeepCopyInto(out *ReceiverSpec) {
 	*out = *in
@@ -1622,6 +2219,21 @@ func (in *ReceiverSpec) DeepCopyInto(out *ReceiverSpec) {
 		*out = new(HTTPReceiver)
 		**out = **in
 	}
+	if in.Syslog != nil {
+		in, out := &in.Syslog, &out.Syslog
+		*out = new(SyslogReceiver)
+		**out = **in
+	}
+	if in.OTLP != nil {
+		in, out := &in.OTLP, &out.OTLP
+		*out = new(OTLPReceiver)
+		**out = **in
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(ReceiverAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverSpec.
@@ -1634,6 +2246,61 @@ func (in *ReceiverSpec) DeepCopy() *ReceiverSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedactFilterSpec) DeepCopyInto(out *RedactFilterSpec) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]FieldPath, len(*in))
+		copy(*out, *in)
+	}
+	if in.Patterns != nil {
+		in, out := &in.Patterns, &out.Patterns
+		*out = make([]RedactPatternType, len(*in))
+		copy(*out, *in)
+	}
+	if in.Regexes != nil {
+		in, out := &in.Regexes, &out.Regexes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Replacement != nil {
+		in, out := &in.Replacement, &out.Replacement
+		*out = new(RedactReplacement)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedactFilterSpec.
+func (in *RedactFilterSpec) DeepCopy() *RedactFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RedactFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedactReplacement) DeepCopyInto(out *RedactReplacement) {
+	*out = *in
+	if in.Visible != nil {
+		in, out := &in.Visible, &out.Visible
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedactReplacement.
+func (in *RedactReplacement) DeepCopy() *RedactReplacement {
+	if in == nil {
+		return nil
+	}
+	out := new(RedactReplacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *S3) DeepCopyInto(out *S3) {
 	*out = *in
@@ -1663,6 +2330,16 @@ func (in *S3) DeepCopy() *S3 {
 func (in *S3TuningSpec) DeepCopyInto(out *S3TuningSpec) {
 	*out = *in
 	in.BaseOutputTuningSpec.DeepCopyInto(&out.BaseOutputTuningSpec)
+	if in.MaxEvents != nil {
+		in, out := &in.MaxEvents, &out.MaxEvents
+		*out = new(int64)
+		**out = **in
+	}
+	if in.BatchTimeout != nil {
+		in, out := &in.BatchTimeout, &out.BatchTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3TuningSpec.
@@ -1688,6 +2365,11 @@ func (in *SASLAuthentication) DeepCopyInto(out *SASLAuthentication) {
 		*out = new(SecretReference)
 		**out = **in
 	}
+	if in.OAuth != nil {
+		in, out := &in.OAuth, &out.OAuth
+		*out = new(SASLOAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SASLAuthentication.
@@ -1700,6 +2382,63 @@ func (in *SASLAuthentication) DeepCopy() *SASLAuthentication {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SASLOAuthentication) DeepCopyInto(out *SASLOAuthentication) {
+	*out = *in
+	if in.ClientID != nil {
+		in, out := &in.ClientID, &out.ClientID
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.ClientSecret != nil {
+		in, out := &in.ClientSecret, &out.ClientSecret
+		*out = new(SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SASLOAuthentication.
+func (in *SASLOAuthentication) DeepCopy() *SASLOAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(SASLOAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SampleFilterSpec) DeepCopyInto(out *SampleFilterSpec) {
+	*out = *in
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Percent != nil {
+		in, out := &in.Percent, &out.Percent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]DropTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SampleFilterSpec.
+func (in *SampleFilterSpec) DeepCopy() *SampleFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SampleFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretReference) DeepCopyInto(out *SecretReference) {
 	*out = *in
@@ -1817,6 +2556,21 @@ func (in *Syslog) DeepCopy() *Syslog {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyslogReceiver) DeepCopyInto(out *SyslogReceiver) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyslogReceiver.
+func (in *SyslogReceiver) DeepCopy() *SyslogReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(SyslogReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyslogTuningSpec) DeepCopyInto(out *SyslogTuningSpec) {
 	*out = *in
@@ -1867,6 +2621,36 @@ func (in *TLSSpec) DeepCopy() *TLSSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThrottleFilterSpec) DeepCopyInto(out *ThrottleFilterSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThrottleFilterSpec.
+func (in *ThrottleFilterSpec) DeepCopy() *ThrottleFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ThrottleFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransformFilterSpec) DeepCopyInto(out *TransformFilterSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransformFilterSpec.
+func (in *TransformFilterSpec) DeepCopy() *TransformFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransformFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *URLSpec) DeepCopyInto(out *URLSpec) {
 	*out = *in
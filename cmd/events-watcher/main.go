@@ -0,0 +1,43 @@
+package main
+
+import (
+	"flag"
+	"os"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	"github.com/openshift/cluster-logging-operator/internal/eventswatcher"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	"github.com/openshift/cluster-logging-operator/version"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+)
+
+// events-watcher watches the events of the cluster and forwards them to the collector running in the same pod
+func main() {
+	var options eventswatcher.Options
+	flag.StringVar(&options.Namespace, "namespace", "", "The namespace of the checkpoint ConfigMap")
+	flag.StringVar(&options.CheckpointName, "checkpoint", "", "The name of the checkpoint ConfigMap")
+	flag.StringVar(&options.Endpoint, "endpoint", "", "The URL of the collector source receiving the events")
+	flag.IntVar(&options.BatchSize, "batch-size", eventswatcher.DefaultBatchSize, "The maximum number of events posted in one request")
+	flag.DurationVar(&options.BatchWait, "batch-wait", eventswatcher.DefaultBatchWait, "The maximum time an event is buffered before it is posted")
+	flag.Parse()
+
+	utils.InitStaticLogger("events-watcher")
+	log.Info("starting up...", "operator_version", version.Version)
+
+	if options.Namespace == "" || options.CheckpointName == "" || options.Endpoint == "" {
+		log.Error(nil, "the namespace, checkpoint and endpoint flags are required")
+		os.Exit(1)
+	}
+
+	client, err := kubernetes.NewForConfig(ctrl.GetConfigOrDie())
+	if err != nil {
+		log.Error(err, "unable to create kubernetes client")
+		os.Exit(1)
+	}
+	if err = eventswatcher.New(client, options).Run(signals.SetupSignalHandler()); err != nil {
+		log.Error(err, "unable to watch events")
+		os.Exit(1)
+	}
+}
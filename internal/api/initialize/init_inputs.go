@@ -57,6 +57,10 @@ func migrateInputReceiver(spec obs.InputSpec, forwarderName string, options util
 	if spec.Receiver != nil && spec.Receiver.TLS != nil {
 		return spec
 	}
+	// A syslog receiver only supports TLS in tcp mode
+	if spec.Receiver != nil && spec.Receiver.Type == obs.ReceiverTypeSyslog && internalobs.SyslogReceiverMode(spec.Receiver) != obs.SyslogReceiverModeTCP {
+		return spec
+	}
 	secretName := fmt.Sprintf("%s-%s", forwarderName, spec.Name)
 	spec.Receiver.TLS = &obs.InputTLSSpec{
 		Key: &obs.SecretReference{
@@ -152,6 +152,50 @@ var _ = Describe("migrateInputs", func() {
 			migratedSpec := migrate(spec, spec)
 			Expect(migratedSpec.Spec.Inputs).To(Equal(spec.Spec.Inputs))
 		})
+		It("should not add TLS settings to a syslog receiver in udp mode", func() {
+			spec = obs.ClusterLogForwarder{
+				Spec: obs.ClusterLogForwarderSpec{
+					Inputs: []obs.InputSpec{
+						{
+							Name: "anapp",
+							Type: obs.InputTypeReceiver,
+							Receiver: &obs.ReceiverSpec{
+								Type:   obs.ReceiverTypeSyslog,
+								Syslog: &obs.SyslogReceiver{Mode: obs.SyslogReceiverModeUDP},
+							},
+						},
+					},
+				},
+			}
+			spec.Name = forwarderName
+
+			migratedSpec := migrate(spec, spec)
+			Expect(migratedSpec.Spec.Inputs[0].Receiver.TLS).To(BeNil())
+			_, found := utils.GetOption[[]*corev1.Secret](initContext, GeneratedSecrets, []*corev1.Secret{})
+			Expect(found).To(BeFalse(), "Exp. no TLS secret to be generated for the receiver")
+		})
+		It("should not add TLS settings to a syslog receiver in both mode", func() {
+			spec = obs.ClusterLogForwarder{
+				Spec: obs.ClusterLogForwarderSpec{
+					Inputs: []obs.InputSpec{
+						{
+							Name: "anapp",
+							Type: obs.InputTypeReceiver,
+							Receiver: &obs.ReceiverSpec{
+								Type:   obs.ReceiverTypeSyslog,
+								Syslog: &obs.SyslogReceiver{Mode: obs.SyslogReceiverModeBoth},
+							},
+						},
+					},
+				},
+			}
+			spec.Name = forwarderName
+
+			migratedSpec := migrate(spec, spec)
+			Expect(migratedSpec.Spec.Inputs[0].Receiver.TLS).To(BeNil())
+			_, found := utils.GetOption[[]*corev1.Secret](initContext, GeneratedSecrets, []*corev1.Secret{})
+			Expect(found).To(BeFalse(), "Exp. no TLS secret to be generated for the receiver")
+		})
 		It("should add TLS settings that match the cert signing service when TLS is not spec'd", func() {
 			spec = obs.ClusterLogForwarder{
 				Spec: obs.ClusterLogForwarderSpec{
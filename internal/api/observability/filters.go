@@ -1,6 +1,9 @@
 package observability
 
-import obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"k8s.io/utils/set"
+)
 
 // FilterMap returns a map of filter names to FilterSpec.
 func FilterMap(spec obs.ClusterLogForwarderSpec) map[string]*obs.FilterSpec {
@@ -20,3 +23,25 @@ func (filters Filters) Names() (names []string) {
 	}
 	return names
 }
+
+// ConfigmapNames returns a unique set of unordered configmap names
+func (filters Filters) ConfigmapNames() []string {
+	names := set.New[string]()
+	for _, f := range filters {
+		if f.EnrichIPFilterSpec != nil && f.EnrichIPFilterSpec.CIDRTable.SecretName == "" && f.EnrichIPFilterSpec.CIDRTable.ConfigMapName != "" {
+			names.Insert(f.EnrichIPFilterSpec.CIDRTable.ConfigMapName)
+		}
+	}
+	return names.UnsortedList()
+}
+
+// SecretNames returns a unique set of unordered secret names
+func (filters Filters) SecretNames() []string {
+	secrets := set.New[string]()
+	for _, f := range filters {
+		if f.EnrichIPFilterSpec != nil && f.EnrichIPFilterSpec.CIDRTable.SecretName != "" {
+			secrets.Insert(f.EnrichIPFilterSpec.CIDRTable.SecretName)
+		}
+	}
+	return secrets.UnsortedList()
+}
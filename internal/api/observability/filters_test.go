@@ -0,0 +1,41 @@
+package observability_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	. "github.com/openshift/cluster-logging-operator/internal/api/observability"
+)
+
+var _ = Describe("Filters", func() {
+	var (
+		filters = Filters{
+			{
+				Name: "configmap-zones",
+				Type: obs.FilterTypeEnrichIP,
+				EnrichIPFilterSpec: &obs.EnrichIPFilterSpec{
+					CIDRTable: obs.ValueReference{Key: "zones.csv", ConfigMapName: "zones"},
+				},
+			},
+			{
+				Name: "secret-zones",
+				Type: obs.FilterTypeEnrichIP,
+				EnrichIPFilterSpec: &obs.EnrichIPFilterSpec{
+					CIDRTable: obs.ValueReference{Key: "zones.csv", SecretName: "private-zones"},
+				},
+			},
+			{
+				Name: "drop",
+				Type: obs.FilterTypeDrop,
+			},
+		}
+	)
+
+	It("#ConfigmapNames should return the configmaps referenced by the filters", func() {
+		Expect(filters.ConfigmapNames()).To(ConsistOf("zones"))
+	})
+
+	It("#SecretNames should return the secrets referenced by the filters", func() {
+		Expect(filters.SecretNames()).To(ConsistOf("private-zones"))
+	})
+})
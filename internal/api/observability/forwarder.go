@@ -17,12 +17,21 @@ type NameList interface {
 }
 
 // DeployAsDeployment evaluates the spec to determine if the collector will be deployed as a deployment.
-// Collector is not a daemonset if the only input source is an HTTP receiver
-// Enabled through an annotation
+// Collector is not a daemonset if the only input sources are receivers, kafka consumers or events which do not
+// collect logs from the nodes. Enabled through an annotation or by an events input which must run once per forwarder
 func DeployAsDeployment(forwarder obs.ClusterLogForwarder) bool {
-	if _, ok := forwarder.Annotations[constants.AnnotationEnableCollectorAsDeployment]; ok {
-		inputTypes := Inputs(forwarder.Spec.Inputs).InputTypes()
-		return len(inputTypes) == 1 && inputTypes[0] == obs.InputTypeReceiver
+	inputs := Inputs(forwarder.Spec.Inputs)
+	if _, ok := forwarder.Annotations[constants.AnnotationEnableCollectorAsDeployment]; ok || inputs.HasEventsSource() {
+		inputTypes := inputs.InputTypes()
+		if len(inputTypes) == 0 {
+			return false
+		}
+		for _, inputType := range inputTypes {
+			if inputType != obs.InputTypeReceiver && inputType != obs.InputTypeKafka && inputType != obs.InputTypeEvents {
+				return false
+			}
+		}
+		return true
 	}
 	return false
 }
@@ -68,7 +77,7 @@ func (spec ClusterLogForwarderSpec) InputSpecsTo(out obs.OutputSpec) (results []
 	inputs := Inputs(spec.Inputs).Map()
 	found := map[string]obs.InputSpec{}
 	for _, p := range spec.Pipelines {
-		if sets.NewString(p.OutputRefs...).Has(out.Name) {
+		if sets.NewString(OutputRefs(p)...).Has(out.Name) {
 			for _, ref := range p.InputRefs {
 				found[ref] = inputs[ref]
 			}
@@ -97,6 +97,14 @@ var _ = Describe("[internal][api][observability]", func() {
 			Expect(DeployAsDeployment(forwarder)).To(BeFalse())
 		})
 
+		It("should be a deployment without the annotation when there is an events input and no node inputs", func() {
+			forwarder.Spec.Inputs = []obs.InputSpec{
+				{Type: obs.InputTypeEvents, Events: &obs.EventsInput{}},
+				{Type: obs.InputTypeReceiver},
+			}
+			Expect(DeployAsDeployment(forwarder)).To(BeTrue())
+		})
+
 		Context("when the forwarder is annotated to enable the feature", func() {
 			BeforeEach(func() {
 				forwarder.Annotations = map[string]string{constants.AnnotationEnableCollectorAsDeployment: "true"}
@@ -108,6 +116,13 @@ var _ = Describe("[internal][api][observability]", func() {
 				}
 				Expect(DeployAsDeployment(forwarder)).To(BeTrue())
 			})
+			It("should be true when there are only receiver and kafka inputs", func() {
+				forwarder.Spec.Inputs = []obs.InputSpec{
+					{Type: obs.InputTypeReceiver},
+					{Type: obs.InputTypeKafka},
+				}
+				Expect(DeployAsDeployment(forwarder)).To(BeTrue())
+			})
 			It("should be false when there are more then just receiver inputs", func() {
 				Expect(DeployAsDeployment(forwarder)).To(BeFalse())
 			})
@@ -1,14 +1,31 @@
 package observability
 
 import (
+	"path/filepath"
 	"regexp"
 	"sort"
+	"strings"
 
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/utils/sets"
 	"k8s.io/utils/set"
 )
 
+const (
+	// DefaultReceiverLogSource is the log source of application receiver records when one is not specified
+	DefaultReceiverLogSource = "http"
+	// OTLPReceiverLogSource is the log source of records received by an OTLP receiver
+	OTLPReceiverLogSource = "otlp"
+	// KafkaInputLogSource is the log source of records consumed by a kafka input
+	KafkaInputLogSource = "kafka"
+	// HostFileInputLogSource is the log source of records collected by a host file input
+	HostFileInputLogSource = "hostFile"
+	// EventsInputLogSource is the log source of the Kubernetes events collected by an events input
+	EventsInputLogSource = "events"
+	// HostFileRootPath is the directory of the host filesystem under which host file inputs may collect files
+	HostFileRootPath = "/var/log"
+)
+
 var (
 	ReservedInputTypes = sets.NewString(
 		obs.InputTypeApplication.String(),
@@ -19,6 +36,10 @@ var (
 	ReservedInfrastructureSources = sets.NewString(obs.InfrastructureSourceContainer.String(), obs.InfrastructureSourceNode.String())
 	ReservedAuditSources          = sets.NewString(obs.AuditSourceKube.String(), obs.AuditSourceOpenShift.String(), obs.AuditSourceAuditd.String(), obs.AuditSourceOVN.String())
 
+	// ReservedReceiverSources are log sources which may not be used as the log source of an application receiver
+	ReservedReceiverSources = sets.NewString(obs.ApplicationSourceContainer.String(), obs.InfrastructureSourceNode.String(), string(obs.ReceiverTypeSyslog), OTLPReceiverLogSource, KafkaInputLogSource, HostFileInputLogSource, EventsInputLogSource).
+				Insert(ReservedAuditSources.List()...)
+
 	InfraNSRegex = regexp.MustCompile(`^(?P<default>default)|(?P<openshift>openshift.*)|(?P<kube>kube.*)$`)
 )
 
@@ -124,6 +145,14 @@ func (inputs Inputs) ConfigmapNames() []string {
 		if i.Receiver != nil && i.Receiver.TLS != nil {
 			names.Insert(ConfigmapsForTLS(obs.TLSSpec(*i.Receiver.TLS))...)
 		}
+		if i.Kafka != nil && i.Kafka.TLS != nil {
+			names.Insert(ConfigmapsForTLS(obs.TLSSpec(*i.Kafka.TLS))...)
+		}
+		if i.Receiver != nil && i.Receiver.Authentication != nil {
+			if ca := i.Receiver.Authentication.ClientCA; ca != nil && ca.SecretName == "" && ca.ConfigMapName != "" {
+				names.Insert(ca.ConfigMapName)
+			}
+		}
 	}
 	return names.UnsortedList()
 }
@@ -135,10 +164,58 @@ func (inputs Inputs) SecretNames() []string {
 		if i.Receiver != nil && i.Receiver.TLS != nil {
 			secrets.Insert(SecretsForTLS(obs.TLSSpec(*i.Receiver.TLS))...)
 		}
+		if i.Kafka != nil {
+			if i.Kafka.TLS != nil {
+				secrets.Insert(SecretsForTLS(obs.TLSSpec(*i.Kafka.TLS))...)
+			}
+			for _, key := range KafkaSASLKeys(i.Kafka.Authentication) {
+				secrets.Insert(key.SecretName)
+			}
+		}
+		if i.Receiver != nil && i.Receiver.Authentication != nil {
+			for _, ref := range ReceiverAuthenticationReferences(i.Receiver.Authentication) {
+				if ref.SecretName != "" {
+					secrets.Insert(ref.SecretName)
+				}
+			}
+		}
 	}
 	return secrets.UnsortedList()
 }
 
+// ReceiverTokenEnvVar is the name of the collector environment variable holding the bearer token of a receiver input
+func ReceiverTokenEnvVar(inputName string) string {
+	return "RECEIVER_TOKEN_" + strings.ToUpper(strings.ReplaceAll(inputName, "-", "_"))
+}
+
+// ReceiverTokens returns the bearer token secret keys of the receiver inputs by the name of their environment variable
+func (inputs Inputs) ReceiverTokens() map[string]*obs.SecretReference {
+	tokens := map[string]*obs.SecretReference{}
+	for _, i := range inputs {
+		if i.Receiver != nil && i.Receiver.Authentication != nil && i.Receiver.Authentication.Token != nil {
+			tokens[ReceiverTokenEnvVar(i.Name)] = i.Receiver.Authentication.Token
+		}
+	}
+	return tokens
+}
+
+// ReceiverAuthenticationReferences returns the secret and configmap keys referenced by the authentication of a receiver
+func ReceiverAuthenticationReferences(auth *obs.ReceiverAuthentication) (refs []*obs.ValueReference) {
+	if auth == nil {
+		return refs
+	}
+	if auth.ClientCA != nil {
+		refs = append(refs, auth.ClientCA)
+	}
+	if auth.Token != nil {
+		refs = append(refs, &obs.ValueReference{
+			Key:        auth.Token.Key,
+			SecretName: auth.Token.SecretName,
+		})
+	}
+	return refs
+}
+
 func (inputs Inputs) HasJournalSource() bool {
 	for _, i := range inputs {
 		if i.Type == obs.InputTypeInfrastructure && i.Infrastructure != nil && (len(i.Infrastructure.Sources) == 0 || set.New(i.Infrastructure.Sources...).Has(obs.InfrastructureSourceNode)) {
@@ -189,6 +266,129 @@ func (inputs Inputs) HasReceiverSource() bool {
 	return false
 }
 
+// HasApplicationReceiverSource returns true if any receiver accepts application logs
+func (inputs Inputs) HasApplicationReceiverSource() bool {
+	for _, i := range inputs {
+		if IsApplicationReceiver(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsApplicationReceiver returns true if the input is an OTLP receiver or an HTTP receiver whose format produces application logs
+func IsApplicationReceiver(input obs.InputSpec) bool {
+	if input.Type != obs.InputTypeReceiver || input.Receiver == nil {
+		return false
+	}
+	switch input.Receiver.Type {
+	case obs.ReceiverTypeOTLP:
+		return true
+	case obs.ReceiverTypeHTTP:
+		return input.Receiver.HTTP != nil && input.Receiver.HTTP.Format != obs.HTTPReceiverFormatKubeAPIAudit
+	}
+	return false
+}
+
+// HasKafkaSource returns true if any input consumes logs from Kafka
+func (inputs Inputs) HasKafkaSource() bool {
+	for _, i := range inputs {
+		if i.Type == obs.InputTypeKafka && i.Kafka != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// HasEventsSource returns true if any input collects the Kubernetes events of the cluster
+func (inputs Inputs) HasEventsSource() bool {
+	for _, i := range inputs {
+		if i.Type == obs.InputTypeEvents && i.Events != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// HasHostFileSource returns true if any input collects files from the host filesystem
+func (inputs Inputs) HasHostFileSource() bool {
+	for _, i := range inputs {
+		if i.Type == obs.InputTypeHostFile && i.HostFile != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// HostFileDirectories returns the sorted list of host directories which contain the files collected by the host
+// file inputs. Directories nested within another directory of the list are omitted.
+func (inputs Inputs) HostFileDirectories() []string {
+	dirs := set.New[string]()
+	for _, i := range inputs {
+		if i.Type != obs.InputTypeHostFile || i.HostFile == nil {
+			continue
+		}
+		for _, p := range i.HostFile.Paths {
+			dirs.Insert(HostFileDirectory(p))
+		}
+	}
+	sorted := dirs.SortedList()
+	results := []string{}
+	for _, dir := range sorted {
+		if len(results) > 0 {
+			last := results[len(results)-1]
+			if strings.HasPrefix(dir, last+"/") {
+				continue
+			}
+		}
+		results = append(results, dir)
+	}
+	return results
+}
+
+// HostFileDirectory returns the longest directory of a host file path which does not contain a glob pattern
+func HostFileDirectory(path string) string {
+	dir := filepath.Dir(path)
+	if i := strings.IndexAny(dir, "*?[{"); i >= 0 {
+		dir = filepath.Dir(dir[:i] + "x")
+	}
+	return filepath.Clean(dir)
+}
+
+// HasOTLPReceiverSource returns true if any input is an OTLP receiver
+func (inputs Inputs) HasOTLPReceiverSource() bool {
+	for _, i := range inputs {
+		if i.Type == obs.InputTypeReceiver && i.Receiver != nil && i.Receiver.Type == obs.ReceiverTypeOTLP {
+			return true
+		}
+	}
+	return false
+}
+
+// ReceiverLogSource returns the log source of records received by an application receiver
+func ReceiverLogSource(receiver *obs.HTTPReceiver) string {
+	if receiver == nil || receiver.LogSource == "" {
+		return DefaultReceiverLogSource
+	}
+	return receiver.LogSource
+}
+
+// SyslogReceiverMode returns the transport mode of a syslog receiver, defaulting to tcp
+func SyslogReceiverMode(receiver *obs.ReceiverSpec) obs.SyslogReceiverMode {
+	if receiver == nil || receiver.Syslog == nil || receiver.Syslog.Mode == "" {
+		return obs.SyslogReceiverModeTCP
+	}
+	return receiver.Syslog.Mode
+}
+
+// OTLPReceiverProtocol returns the transport protocol of an OTLP receiver, defaulting to http
+func OTLPReceiverProtocol(receiver *obs.ReceiverSpec) obs.OTLPReceiverProtocol {
+	if receiver == nil || receiver.OTLP == nil || receiver.OTLP.Protocol == "" {
+		return obs.OTLPReceiverProtocolHTTP
+	}
+	return receiver.OTLP.Protocol
+}
+
 type InfrastructureSources []obs.InfrastructureSource
 
 func (infraSources InfrastructureSources) AsStrings() (result []string) {
@@ -62,3 +62,105 @@ var _ = Describe("#InputSources", func() {
 		})
 	})
 })
+
+var _ = Describe("#IsApplicationReceiver", func() {
+
+	DescribeTable("should identify receivers of application logs", func(input obs.InputSpec, exp bool) {
+		Expect(IsApplicationReceiver(input)).To(Equal(exp))
+		Expect(Inputs{input}.HasApplicationReceiverSource()).To(Equal(exp))
+	},
+		Entry("for the json format", obs.InputSpec{Type: obs.InputTypeReceiver, Receiver: &obs.ReceiverSpec{Type: obs.ReceiverTypeHTTP, HTTP: &obs.HTTPReceiver{Format: obs.HTTPReceiverFormatJSON}}}, true),
+		Entry("for the text format", obs.InputSpec{Type: obs.InputTypeReceiver, Receiver: &obs.ReceiverSpec{Type: obs.ReceiverTypeHTTP, HTTP: &obs.HTTPReceiver{Format: obs.HTTPReceiverFormatText}}}, true),
+		Entry("for an otlp receiver", obs.InputSpec{Type: obs.InputTypeReceiver, Receiver: &obs.ReceiverSpec{Type: obs.ReceiverTypeOTLP}}, true),
+		Entry("not for the kubeAPIAudit format", obs.InputSpec{Type: obs.InputTypeReceiver, Receiver: &obs.ReceiverSpec{Type: obs.ReceiverTypeHTTP, HTTP: &obs.HTTPReceiver{Format: obs.HTTPReceiverFormatKubeAPIAudit}}}, false),
+		Entry("not for a syslog receiver", obs.InputSpec{Type: obs.InputTypeReceiver, Receiver: &obs.ReceiverSpec{Type: obs.ReceiverTypeSyslog}}, false),
+		Entry("not for an application input", obs.InputSpec{Type: obs.InputTypeApplication}, false),
+	)
+
+	It("should default the log source of an application receiver", func() {
+		Expect(ReceiverLogSource(&obs.HTTPReceiver{Format: obs.HTTPReceiverFormatJSON})).To(Equal(DefaultReceiverLogSource))
+		Expect(ReceiverLogSource(&obs.HTTPReceiver{Format: obs.HTTPReceiverFormatJSON, LogSource: "myagent"})).To(Equal("myagent"))
+	})
+})
+
+var _ = Describe("#SyslogReceiverMode", func() {
+
+	It("should default to tcp", func() {
+		Expect(SyslogReceiverMode(&obs.ReceiverSpec{Type: obs.ReceiverTypeSyslog})).To(Equal(obs.SyslogReceiverModeTCP))
+		Expect(SyslogReceiverMode(&obs.ReceiverSpec{Type: obs.ReceiverTypeSyslog, Syslog: &obs.SyslogReceiver{}})).To(Equal(obs.SyslogReceiverModeTCP))
+	})
+
+	It("should return the spec'd mode", func() {
+		Expect(SyslogReceiverMode(&obs.ReceiverSpec{Type: obs.ReceiverTypeSyslog, Syslog: &obs.SyslogReceiver{Mode: obs.SyslogReceiverModeBoth}})).To(Equal(obs.SyslogReceiverModeBoth))
+	})
+})
+
+var _ = Describe("#OTLPReceiverProtocol", func() {
+
+	It("should default to http", func() {
+		Expect(OTLPReceiverProtocol(&obs.ReceiverSpec{Type: obs.ReceiverTypeOTLP})).To(Equal(obs.OTLPReceiverProtocolHTTP))
+		Expect(OTLPReceiverProtocol(&obs.ReceiverSpec{Type: obs.ReceiverTypeOTLP, OTLP: &obs.OTLPReceiver{}})).To(Equal(obs.OTLPReceiverProtocolHTTP))
+	})
+
+	It("should return the spec'd protocol", func() {
+		Expect(OTLPReceiverProtocol(&obs.ReceiverSpec{Type: obs.ReceiverTypeOTLP, OTLP: &obs.OTLPReceiver{Protocol: obs.OTLPReceiverProtocolGRPC}})).To(Equal(obs.OTLPReceiverProtocolGRPC))
+	})
+})
+
+var _ = Describe("#ReceiverAuthenticationReferences", func() {
+
+	It("should include the secrets and configmaps of the receiver authentication in the referenced names", func() {
+		inputs := Inputs{
+			{Name: "myreceiver", Type: obs.InputTypeReceiver, Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeHTTP,
+				Authentication: &obs.ReceiverAuthentication{
+					ClientCA: &obs.ValueReference{Key: "ca.crt", ConfigMapName: "clients"},
+					Token:    &obs.SecretReference{Key: "token", SecretName: "tokens"},
+				},
+			}},
+		}
+		Expect(inputs.SecretNames()).To(ConsistOf("tokens"))
+		Expect(inputs.ConfigmapNames()).To(ConsistOf("clients"))
+	})
+
+	It("should return the bearer tokens of the receivers by environment variable", func() {
+		token := &obs.SecretReference{Key: "token", SecretName: "tokens"}
+		inputs := Inputs{
+			{Name: "my-receiver", Type: obs.InputTypeReceiver, Receiver: &obs.ReceiverSpec{
+				Type:           obs.ReceiverTypeHTTP,
+				Authentication: &obs.ReceiverAuthentication{Token: token},
+			}},
+			{Name: "my-other-receiver", Type: obs.InputTypeReceiver, Receiver: &obs.ReceiverSpec{
+				Type:           obs.ReceiverTypeHTTP,
+				Authentication: &obs.ReceiverAuthentication{ClientCA: &obs.ValueReference{Key: "ca.crt", ConfigMapName: "clients"}},
+			}},
+			{Name: "my-app", Type: obs.InputTypeApplication},
+		}
+		Expect(inputs.ReceiverTokens()).To(Equal(map[string]*obs.SecretReference{"RECEIVER_TOKEN_MY_RECEIVER": token}))
+	})
+})
+
+var _ = Describe("#HostFileDirectories", func() {
+
+	DescribeTable("should return the directory of a path before any glob pattern", func(path, exp string) {
+		Expect(HostFileDirectory(path)).To(Equal(exp))
+	},
+		Entry("for a file", "/var/log/acme/agent.log", "/var/log/acme"),
+		Entry("for a glob file name", "/var/log/acme/*.log", "/var/log/acme"),
+		Entry("for a recursive glob", "/var/log/acme/**/*.log", "/var/log/acme"),
+		Entry("for a glob directory", "/var/log/acme-*/agent.log", "/var/log"),
+	)
+
+	It("should return the unique directories of all host file inputs omitting nested ones", func() {
+		inputs := Inputs{
+			{Name: "vendor", Type: obs.InputTypeHostFile, HostFile: &obs.HostFileInput{
+				Paths: []string{"/var/log/acme/*.log", "/var/log/acme/debug/*.log", "/var/log/other/agent.log"},
+			}},
+			{Name: "more", Type: obs.InputTypeHostFile, HostFile: &obs.HostFileInput{
+				Paths: []string{"/var/log/other/*.log"},
+			}},
+			{Name: "app", Type: obs.InputTypeApplication, Application: &obs.Application{}},
+		}
+		Expect(inputs.HostFileDirectories()).To(Equal([]string{"/var/log/acme", "/var/log/other"}))
+	})
+})
@@ -9,6 +9,25 @@ import (
 	"k8s.io/utils/set"
 )
 
+const (
+	// KafkaSASLMechanismOAuthBearer is the SASL mechanism of Kafka OAuth authentication
+	KafkaSASLMechanismOAuthBearer = "OAUTHBEARER"
+	// KafkaSASLMechanismAwsMskIam is the SASL mechanism of Amazon MSK IAM authentication, which is not supported
+	KafkaSASLMechanismAwsMskIam = "AWS_MSK_IAM"
+)
+
+// AllowedLibrdKafkaOptions are the librdkafka options which may be tuned by the user. Options which change
+// the delivery semantics of the producer, the security or the size of the messages are managed by the operator
+var AllowedLibrdKafkaOptions = []string{
+	"acks",
+	"linger.ms",
+	"batch.size",
+	"batch.num.messages",
+	"queue.buffering.max.messages",
+	"queue.buffering.max.kbytes",
+	"request.timeout.ms",
+}
+
 func OutputTypeUnknown(t obsv1.OutputType) error {
 	return fmt.Errorf("unknown output type %q", t)
 }
@@ -92,10 +111,20 @@ func getOutputBearerToken(o obsv1.OutputSpec) *obsv1.BearerToken {
 			o.AzureLogsIngestion.Authentication.WorkloadIdentity != nil {
 			return o.AzureLogsIngestion.Authentication.WorkloadIdentity.Token
 		}
+	case obsv1.OutputTypeAzureBlob:
+		if o.AzureBlob != nil && o.AzureBlob.Authentication != nil &&
+			o.AzureBlob.Authentication.Type == obsv1.AzureLogsIngestionAuthTypeWorkloadIdentity &&
+			o.AzureBlob.Authentication.WorkloadIdentity != nil {
+			return o.AzureBlob.Authentication.WorkloadIdentity.Token
+		}
 	case obsv1.OutputTypeGoogleCloudLogging:
 		if o.GoogleCloudLogging != nil && o.GoogleCloudLogging.Authentication != nil {
 			return o.GoogleCloudLogging.Authentication.Token
 		}
+	case obsv1.OutputTypeGoogleCloudStorage:
+		if o.GoogleCloudStorage != nil && o.GoogleCloudStorage.Authentication != nil {
+			return o.GoogleCloudStorage.Authentication.Token
+		}
 	}
 	return nil
 }
@@ -133,6 +162,10 @@ func SecretReferencesAsValueReferences(o obsv1.OutputSpec) (configs []*obsv1.Val
 // to be nil if it was not specified for the output
 func SecretReferences(o obsv1.OutputSpec) []*obsv1.SecretReference {
 	switch o.Type {
+	case obsv1.OutputTypeAzureBlob:
+		if o.AzureBlob != nil && o.AzureBlob.Authentication != nil {
+			return azureLogsIngestionKeys(o.AzureBlob.Authentication)
+		}
 	case obsv1.OutputTypeAzureLogsIngestion:
 		if o.AzureLogsIngestion != nil && o.AzureLogsIngestion.Authentication != nil {
 			auth := o.AzureLogsIngestion.Authentication
@@ -160,6 +193,10 @@ func SecretReferences(o obsv1.OutputSpec) []*obsv1.SecretReference {
 		if o.GoogleCloudLogging != nil && o.GoogleCloudLogging.Authentication != nil {
 			return gclSecretKeys(o.GoogleCloudLogging.Authentication)
 		}
+	case obsv1.OutputTypeGoogleCloudStorage:
+		if o.GoogleCloudStorage != nil && o.GoogleCloudStorage.Authentication != nil {
+			return gclSecretKeys(o.GoogleCloudStorage.Authentication)
+		}
 	case obsv1.OutputTypeHTTP:
 		if o.HTTP != nil && o.HTTP.Authentication != nil {
 			return httpAuthKeys(o.HTTP.Authentication)
@@ -170,8 +207,7 @@ func SecretReferences(o obsv1.OutputSpec) []*obsv1.SecretReference {
 		}
 	case obsv1.OutputTypeKafka:
 		if o.Kafka != nil && o.Kafka.Authentication != nil {
-			a := o.Kafka.Authentication
-			return []*obsv1.SecretReference{a.SASL.Password, a.SASL.Username}
+			return KafkaSASLKeys(o.Kafka.Authentication)
 		}
 	case obsv1.OutputTypeLoki:
 		if o.Loki != nil {
@@ -193,6 +229,23 @@ func SecretReferences(o obsv1.OutputSpec) []*obsv1.SecretReference {
 	return []*obsv1.SecretReference{}
 }
 
+// KafkaSASLKeys returns the secret keys of the SASL credentials of a Kafka output or input
+func KafkaSASLKeys(auth *obsv1.KafkaAuthentication) (keys []*obsv1.SecretReference) {
+	if auth == nil || auth.SASL == nil {
+		return keys
+	}
+	if auth.SASL.Password != nil {
+		keys = append(keys, auth.SASL.Password)
+	}
+	if auth.SASL.Username != nil {
+		keys = append(keys, auth.SASL.Username)
+	}
+	if oauth := auth.SASL.OAuth; oauth != nil {
+		keys = append(keys, oauth.ClientID, oauth.ClientSecret)
+	}
+	return keys
+}
+
 func httpAuthKeys(auth *obsv1.HTTPAuthentication) []*obsv1.SecretReference {
 	if auth != nil {
 		keys := []*obsv1.SecretReference{
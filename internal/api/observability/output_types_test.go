@@ -28,7 +28,6 @@ var _ = Describe("helpers for output types", func() {
 				Expect(SecretReferences(*spec)).To(BeEmpty())
 			}
 		})
-
 	})
 })
 
@@ -404,3 +403,108 @@ var _ = Describe("S3 secret handling", func() {
 		})
 	})
 })
+
+var _ = Describe("Object storage secret handling", func() {
+	Context("SecretReferences", func() {
+		It("should return the client secret for AzureBlob with client secret authentication", func() {
+			output := obsv1.OutputSpec{
+				Type: obsv1.OutputTypeAzureBlob,
+				AzureBlob: &obsv1.AzureBlob{
+					Authentication: &obsv1.AzureLogsIngestionAuthentication{
+						Type: obsv1.AzureLogsIngestionAuthTypeClientSecret,
+						ClientSecret: &obsv1.AzureLogsIngestionClientSecret{
+							Secret: &obsv1.SecretReference{
+								SecretName: "azure-blob-secret",
+								Key:        "client_secret",
+							},
+						},
+					},
+				},
+			}
+
+			refs := SecretReferences(output)
+			Expect(refs).To(HaveLen(1))
+			Expect(refs[0].SecretName).To(Equal("azure-blob-secret"))
+			Expect(refs[0].Key).To(Equal("client_secret"))
+		})
+
+		It("should return the credentials for GoogleCloudStorage", func() {
+			output := obsv1.OutputSpec{
+				Type: obsv1.OutputTypeGoogleCloudStorage,
+				GoogleCloudStorage: &obsv1.GoogleCloudStorage{
+					Authentication: &obsv1.GoogleCloudLoggingAuthentication{
+						Credentials: &obsv1.SecretReference{
+							SecretName: "gcs-secret",
+							Key:        "google-application-credentials.json",
+						},
+					},
+				},
+			}
+
+			refs := SecretReferences(output)
+			Expect(refs).To(HaveLen(1))
+			Expect(refs[0].SecretName).To(Equal("gcs-secret"))
+		})
+	})
+
+	Context("NeedServiceAccountToken", func() {
+		It("should return true for AzureBlob with workload identity using the service account token", func() {
+			outputs := Outputs{
+				{
+					Type: obsv1.OutputTypeAzureBlob,
+					AzureBlob: &obsv1.AzureBlob{
+						Authentication: &obsv1.AzureLogsIngestionAuthentication{
+							Type: obsv1.AzureLogsIngestionAuthTypeWorkloadIdentity,
+							WorkloadIdentity: &obsv1.AzureLogsIngestionWorkloadIdentity{
+								Token: &obsv1.BearerToken{From: obsv1.BearerTokenFromServiceAccount},
+							},
+						},
+					},
+				},
+			}
+			Expect(outputs.NeedServiceAccountToken()).To(BeTrue())
+		})
+
+		It("should return true for GoogleCloudStorage using the service account token", func() {
+			outputs := Outputs{
+				{
+					Type: obsv1.OutputTypeGoogleCloudStorage,
+					GoogleCloudStorage: &obsv1.GoogleCloudStorage{
+						Authentication: &obsv1.GoogleCloudLoggingAuthentication{
+							Token: &obsv1.BearerToken{From: obsv1.BearerTokenFromServiceAccount},
+						},
+					},
+				},
+			}
+			Expect(outputs.NeedServiceAccountToken()).To(BeTrue())
+		})
+	})
+})
+
+var _ = Describe("Kafka SASL secret handling", func() {
+	var (
+		kafkaOutput = func(sasl *obsv1.SASLAuthentication) obsv1.OutputSpec {
+			return obsv1.OutputSpec{
+				Type: obsv1.OutputTypeKafka,
+				Kafka: &obsv1.Kafka{
+					Authentication: &obsv1.KafkaAuthentication{SASL: sasl},
+				},
+			}
+		}
+	)
+
+	Context("SecretReferences", func() {
+		It("should return the client credentials for OAuth", func() {
+			refs := SecretReferences(kafkaOutput(&obsv1.SASLAuthentication{
+				OAuth: &obsv1.SASLOAuthentication{
+					TokenURL:     "https://idp.example.com/oauth2/token",
+					ClientID:     &obsv1.SecretReference{SecretName: "oauth-secret", Key: "client_id"},
+					ClientSecret: &obsv1.SecretReference{SecretName: "oauth-secret", Key: "client_secret"},
+				},
+			}))
+			Expect(refs).To(HaveLen(2))
+			Expect(refs[0].Key).To(Equal("client_id"))
+			Expect(refs[1].Key).To(Equal("client_secret"))
+		})
+	})
+})
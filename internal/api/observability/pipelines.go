@@ -27,9 +27,18 @@ func (pipeline Pipelines) Map() map[string]obs.PipelineSpec {
 // ReferenceOutput iterates through the list of pipelines to see if any reference the given output
 func (pipeline Pipelines) ReferenceOutput(output obs.OutputSpec) bool {
 	for _, i := range pipeline {
-		if set.New(i.OutputRefs...).Has(output.Name) {
+		if set.New(OutputRefs(i)...).Has(output.Name) {
 			return true
 		}
 	}
 	return false
 }
+
+// OutputRefs returns the names of the outputs referenced by the pipeline and its routes
+func OutputRefs(pipeline obs.PipelineSpec) []string {
+	refs := set.New(pipeline.OutputRefs...)
+	for _, r := range pipeline.Routes {
+		refs.Insert(r.OutputRefs...)
+	}
+	return refs.SortedList()
+}
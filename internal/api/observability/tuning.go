@@ -16,6 +16,11 @@ type Tuning struct {
 func NewTuning(spec obs.OutputSpec) Tuning {
 	t := Tuning{}
 	switch spec.Type {
+	case obs.OutputTypeAzureBlob:
+		if spec.AzureBlob != nil && spec.AzureBlob.Tuning != nil {
+			t.BaseOutputTuningSpec = spec.AzureBlob.Tuning.BaseOutputTuningSpec
+			t.Compression = spec.AzureBlob.Tuning.Compression
+		}
 	case obs.OutputTypeAzureLogsIngestion:
 		if spec.AzureLogsIngestion != nil && spec.AzureLogsIngestion.Tuning != nil {
 			t.BaseOutputTuningSpec = spec.AzureLogsIngestion.Tuning.BaseOutputTuningSpec
@@ -28,6 +33,11 @@ func NewTuning(spec obs.OutputSpec) Tuning {
 		if spec.GoogleCloudLogging != nil && spec.GoogleCloudLogging.Tuning != nil {
 			t.BaseOutputTuningSpec = spec.GoogleCloudLogging.Tuning.BaseOutputTuningSpec
 		}
+	case obs.OutputTypeGoogleCloudStorage:
+		if spec.GoogleCloudStorage != nil && spec.GoogleCloudStorage.Tuning != nil {
+			t.BaseOutputTuningSpec = spec.GoogleCloudStorage.Tuning.BaseOutputTuningSpec
+			t.Compression = spec.GoogleCloudStorage.Tuning.Compression
+		}
 	case obs.OutputTypeCloudwatch:
 		if spec.Cloudwatch != nil && spec.Cloudwatch.Tuning != nil {
 			t.BaseOutputTuningSpec = spec.Cloudwatch.Tuning.BaseOutputTuningSpec
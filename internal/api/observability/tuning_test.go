@@ -58,6 +58,24 @@ var _ = Describe("Output", func() {
 				},
 			},
 		}, baseSpec, ""),
+		Entry("with AzureBlob", obs.OutputSpec{
+			Type: obs.OutputTypeAzureBlob,
+			AzureBlob: &obs.AzureBlob{
+				Tuning: &obs.AzureBlobTuningSpec{
+					BaseOutputTuningSpec: *baseSpec,
+					Compression:          compression,
+				},
+			},
+		}, baseSpec, compression),
+		Entry("with GoogleCloudStorage", obs.OutputSpec{
+			Type: obs.OutputTypeGoogleCloudStorage,
+			GoogleCloudStorage: &obs.GoogleCloudStorage{
+				Tuning: &obs.GoogleCloudStorageTuningSpec{
+					BaseOutputTuningSpec: *baseSpec,
+					Compression:          compression,
+				},
+			},
+		}, baseSpec, compression),
 		Entry("with Cloudwatch", obs.OutputSpec{
 			Type: obs.OutputTypeCloudwatch,
 			Cloudwatch: &obs.Cloudwatch{
@@ -12,7 +12,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-const systemAuthDelegatorClusterRoleName = "system:auth-delegator"
+const (
+	systemAuthDelegatorClusterRoleName = "system:auth-delegator"
+	eventsReaderClusterRoleName        = "events-reader"
+)
 
 // ReconcileRBAC reconciles the RBAC specifically for the service account and SCC
 func ReconcileRBAC(k8sClient client.Client, rbacName, saNamespace, saName string, owner metav1.OwnerReference) error {
@@ -69,6 +72,83 @@ func DeleteMetricsAuthRBAC(k8sClient client.Client, name string) error {
 	return reconcile.DeleteClusterRoleBinding(k8sClient, name)
 }
 
+// ReconcileEventsReaderRBAC reconciles the ClusterRoleBinding that allows the service account to list the events of the cluster
+func ReconcileEventsReaderRBAC(k8sClient client.Client, name, saNamespace, saName string) error {
+	desiredEventsReaderRoleBinding := NewEventsReaderClusterRoleBinding(name, saNamespace, saName)
+	return reconcile.ClusterRoleBinding(k8sClient, desiredEventsReaderRoleBinding.Name, func() *rbacv1.ClusterRoleBinding { return desiredEventsReaderRoleBinding })
+}
+
+// DeleteEventsReaderRBAC deletes the ClusterRoleBinding for reading events
+func DeleteEventsReaderRBAC(k8sClient client.Client, name string) error {
+	return reconcile.DeleteClusterRoleBinding(k8sClient, name)
+}
+
+// ReconcileEventsWatcherRBAC reconciles the Role and RoleBinding that allow the service account to save the
+// checkpoint of the events watcher
+func ReconcileEventsWatcherRBAC(k8sClient client.Client, namespace, name, checkpointName, saName string, owner metav1.OwnerReference) error {
+	if err := reconcile.Role(k8sClient, NewEventsWatcherRole(namespace, name, checkpointName, owner)); err != nil {
+		return err
+	}
+	return reconcile.RoleBinding(k8sClient, NewEventsWatcherRoleBinding(namespace, name, saName, owner))
+}
+
+// DeleteEventsWatcherRBAC deletes the Role and RoleBinding of the events watcher
+func DeleteEventsWatcherRBAC(k8sClient client.Client, namespace, name string) error {
+	if err := reconcile.DeleteRoleBinding(k8sClient, namespace, name); err != nil {
+		return err
+	}
+	return reconcile.DeleteRole(k8sClient, namespace, name)
+}
+
+// NewEventsWatcherRole allows reading and updating the checkpoint ConfigMap of the events watcher
+func NewEventsWatcherRole(namespace, name, checkpointName string, owner metav1.OwnerReference) *rbacv1.Role {
+	desired := runtime.NewRole(namespace, name,
+		rbacv1.PolicyRule{
+			APIGroups:     []string{""},
+			ResourceNames: []string{checkpointName},
+			Resources:     []string{"configmaps"},
+			Verbs:         []string{"get", "patch"},
+		},
+	)
+	utils.AddOwnerRefToObject(desired, owner)
+	return desired
+}
+
+// NewEventsWatcherRoleBinding binds the events watcher Role to the given service account
+func NewEventsWatcherRoleBinding(namespace, name, saName string, owner metav1.OwnerReference) *rbacv1.RoleBinding {
+	desired := runtime.NewRoleBinding(namespace, name,
+		rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     name,
+		},
+		rbacv1.Subject{
+			Kind:      "ServiceAccount",
+			Name:      saName,
+			Namespace: namespace,
+		},
+	)
+	utils.AddOwnerRefToObject(desired, owner)
+	return desired
+}
+
+// NewEventsReaderClusterRoleBinding binds the events-reader ClusterRole to the given service account.
+func NewEventsReaderClusterRoleBinding(name, saNamespace, saName string) *rbacv1.ClusterRoleBinding {
+	return runtime.NewClusterRoleBinding(
+		name,
+		rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     eventsReaderClusterRoleName,
+		},
+		rbacv1.Subject{
+			Kind:      "ServiceAccount",
+			Name:      saName,
+			Namespace: saNamespace,
+		},
+	)
+}
+
 // NewMetricsAuthClusterRoleBinding binds the system:auth-delegator ClusterRole to the given service account.
 func NewMetricsAuthClusterRoleBinding(name, saNamespace, saName string) *rbacv1.ClusterRoleBinding {
 	return runtime.NewClusterRoleBinding(
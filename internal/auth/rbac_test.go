@@ -33,6 +33,25 @@ subjects:
 	})
 })
 
+var _ = Describe("NewEventsReaderClusterRoleBinding", func() {
+	It("should stub a well-formed clusterrolebinding", func() {
+		Expect(test.YAMLString(auth.NewEventsReaderClusterRoleBinding("cluster-logging-openshift-logging-my-clf-events-reader", constants.OpenshiftNS, "logcollector"))).To(MatchYAML(
+			`apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRoleBinding
+metadata:
+  name: cluster-logging-openshift-logging-my-clf-events-reader
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: events-reader
+subjects:
+- kind: ServiceAccount
+  name: logcollector
+  namespace: openshift-logging
+`))
+	})
+})
+
 var _ = Describe("ServiceAccount SCC Role & RoleBinding", func() {
 	It("should stub a well-formed role", func() {
 		Expect(test.YAMLString(auth.NewServiceAccountSCCRole(constants.OpenshiftNS, "my-clf", "my-sa", metav1.OwnerReference{}))).To(MatchYAML(
@@ -72,3 +91,43 @@ subjects:
 	})
 
 })
+
+var _ = Describe("Events watcher Role & RoleBinding", func() {
+	It("should stub a role restricted to the checkpoint configmap", func() {
+		Expect(test.YAMLString(auth.NewEventsWatcherRole(constants.OpenshiftNS, "my-clf-events-watcher", "my-clf-events-checkpoint", metav1.OwnerReference{}))).To(MatchYAML(
+			`apiVersion: rbac.authorization.k8s.io/v1
+kind: Role
+metadata:
+  name: my-clf-events-watcher
+  namespace: openshift-logging
+rules:
+- apiGroups:
+    - ""
+  resourceNames:
+    - my-clf-events-checkpoint
+  resources:
+    - configmaps
+  verbs:
+    - get
+    - patch
+`))
+	})
+
+	It("should stub a well-formed roleBinding", func() {
+		Expect(test.YAMLString(auth.NewEventsWatcherRoleBinding(constants.OpenshiftNS, "my-clf-events-watcher", "my-sa", metav1.OwnerReference{}))).To(MatchYAML(
+			`apiVersion: rbac.authorization.k8s.io/v1
+kind: RoleBinding
+metadata:
+  name: my-clf-events-watcher
+  namespace: openshift-logging
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: Role
+  name: my-clf-events-watcher
+subjects:
+  - kind: ServiceAccount
+    name: my-sa
+    namespace: openshift-logging
+`))
+	})
+})
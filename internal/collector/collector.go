@@ -2,6 +2,7 @@ package collector
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -49,8 +50,10 @@ const (
 	sourceOpenshiftAPIServerPath               = "/var/log/openshift-apiserver"
 	sourceKubeAPIServerName                    = "varlogkubeapiserver"
 	sourceKubeAPIServerPath                    = "/var/log/kube-apiserver"
+	sourceHostFileName                         = "hostfile"
 	tmpVolumeName                              = "tmp"
 	tmpPath                                    = "/tmp"
+	defaultDeploymentReplicas            int32 = 2
 )
 
 type Visitor func(collector *v1.Container, podSpec *v1.PodSpec, resNames *factory.ForwarderResourceNames, namespace, logLevel string)
@@ -137,12 +140,25 @@ func (f *Factory) NewDaemonSet(namespace, name string, trustedCABundle *v1.Confi
 
 func (f *Factory) NewDeployment(namespace, name string, trustedCABundle *v1.ConfigMap, tlsProfileSpec configv1.TLSProfileSpec) *apps.Deployment {
 	podSpec := f.NewPodSpec(trustedCABundle, f.ForwarderSpec, f.ClusterID, tlsProfileSpec, namespace)
-	dpl := factory.NewDeployment(namespace, name, constants.CollectorName, constants.VectorName, 2, *podSpec, f.CommonLabelInitializer, f.PodLabelVisitor)
+	dpl := factory.NewDeployment(namespace, name, constants.CollectorName, constants.VectorName, f.DeploymentReplicas(), *podSpec, f.CommonLabelInitializer, f.PodLabelVisitor)
 	dpl.Spec.Template.Annotations[constants.AnnotationSecretHash] = f.Secrets.Hash64a()
 	dpl.Spec.Template.Annotations[constants.AnnotationConfigMapHash] = f.ConfigMaps.Hash64a()
+	if internalobs.Inputs(f.ForwarderSpec.Inputs).HasEventsSource() {
+		// replace the pod before starting a new one to avoid collecting the events twice
+		dpl.Spec.Strategy = apps.DeploymentStrategy{Type: apps.RecreateDeploymentStrategyType}
+	}
 	return dpl
 }
 
+// DeploymentReplicas returns the number of replicas of the collector deployment. Events are collected
+// by a single replica
+func (f *Factory) DeploymentReplicas() int32 {
+	if internalobs.Inputs(f.ForwarderSpec.Inputs).HasEventsSource() {
+		return 1
+	}
+	return defaultDeploymentReplicas
+}
+
 func (f *Factory) NewPodSpec(trustedCABundle *v1.ConfigMap, spec obs.ClusterLogForwarderSpec, clusterID string, tlsProfileSpec configv1.TLSProfileSpec, namespace string) *v1.PodSpec {
 
 	var gracePeriod *int64
@@ -200,6 +216,14 @@ func (f *Factory) NewPodSpec(trustedCABundle *v1.ConfigMap, spec obs.ClusterLogF
 				v1.Volume{Name: sourceAuditOVNName, VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: sourceOVNPath}}},
 			)
 		}
+		for i, dir := range inputs.HostFileDirectories() {
+			if hasHostPathVolume(podSpec, dir) {
+				continue
+			}
+			podSpec.Volumes = append(podSpec.Volumes,
+				v1.Volume{Name: hostFileVolumeName(i), VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: dir}}},
+			)
+		}
 	}
 
 	secretVolumes := AddSecretVolumes(podSpec, f.Secrets)
@@ -217,9 +241,36 @@ func (f *Factory) NewPodSpec(trustedCABundle *v1.ConfigMap, spec obs.ClusterLogF
 	podSpec.Containers = []v1.Container{
 		*collector,
 	}
+	if internalobs.Inputs(spec.Inputs).HasEventsSource() {
+		podSpec.Containers = append(podSpec.Containers, *f.NewEventsWatcherContainer(namespace))
+	}
 	return podSpec
 }
 
+// NewEventsWatcherContainer is a constructor for the container which watches the events of the cluster and posts
+// them to the events source of the collector
+func (f *Factory) NewEventsWatcherContainer(namespace string) *v1.Container {
+	watcher := runtime.NewContainer(constants.EventsWatcherName, utils.GetComponentImage(constants.EventsWatcherName), v1.PullIfNotPresent, nil)
+	watcher.TerminationMessagePolicy = v1.TerminationMessageFallbackToLogsOnError
+	watcher.Command = []string{"/usr/bin/events-watcher"}
+	watcher.Args = []string{
+		"--namespace=" + namespace,
+		"--checkpoint=" + f.ResourceNames.EventsCheckpoint,
+		fmt.Sprintf("--endpoint=http://127.0.0.1:%d", constants.EventsWatcherPort),
+	}
+	watcher.SecurityContext = &v1.SecurityContext{
+		Capabilities: &v1.Capabilities{
+			Drop: auth.RequiredDropCapabilities,
+		},
+		ReadOnlyRootFilesystem:   utils.GetPtr(true),
+		AllowPrivilegeEscalation: utils.GetPtr(false),
+		SeccompProfile: &v1.SeccompProfile{
+			Type: v1.SeccompProfileTypeRuntimeDefault,
+		},
+	}
+	return watcher
+}
+
 // NewCollectorContainer is a constructor for creating the collector container spec.  Note the secretNames are assumed
 // to be a unique list
 func (f *Factory) NewCollectorContainer(inputs internalobs.Inputs, outputs internalobs.Outputs, secretVolumes, configmapVolumes []string, clusterID string) *v1.Container {
@@ -242,6 +293,7 @@ func (f *Factory) NewCollectorContainer(inputs internalobs.Inputs, outputs inter
 		{Name: "VECTOR_RAISE_FD_LIMIT", Value: "true"},
 	}
 	collector.Env = append(collector.Env, utils.GetProxyEnvVars()...)
+	collector.Env = append(collector.Env, receiverTokenEnvVars(inputs)...)
 
 	collector.VolumeMounts = []v1.VolumeMount{
 		{Name: metricsVolumeName, ReadOnly: true, MountPath: metricsVolumePath},
@@ -269,6 +321,12 @@ func (f *Factory) NewCollectorContainer(inputs internalobs.Inputs, outputs inter
 		if inputs.HasAuditSource(obs.AuditSourceOVN) {
 			collector.VolumeMounts = append(collector.VolumeMounts, v1.VolumeMount{Name: sourceAuditOVNName, ReadOnly: true, MountPath: sourceOVNPath})
 		}
+		for i, dir := range inputs.HostFileDirectories() {
+			if hasVolumeMount(collector, dir) {
+				continue
+			}
+			collector.VolumeMounts = append(collector.VolumeMounts, v1.VolumeMount{Name: hostFileVolumeName(i), ReadOnly: true, MountPath: dir})
+		}
 		AddSecurityContextTo(collector)
 	}
 
@@ -287,6 +345,31 @@ func (f *Factory) NewCollectorContainer(inputs internalobs.Inputs, outputs inter
 	return collector
 }
 
+// hostFileVolumeName returns the name of the volume of the i-th directory of the host file inputs
+func hostFileVolumeName(i int) string {
+	return fmt.Sprintf("%s-%d", sourceHostFileName, i)
+}
+
+// hasHostPathVolume returns true if the host path is already a volume of the pod spec
+func hasHostPathVolume(podSpec *v1.PodSpec, path string) bool {
+	for _, volume := range podSpec.Volumes {
+		if volume.HostPath != nil && volume.HostPath.Path == path {
+			return true
+		}
+	}
+	return false
+}
+
+// hasVolumeMount returns true if the path is already a mount of the collector container
+func hasVolumeMount(collector *v1.Container, path string) bool {
+	for _, mount := range collector.VolumeMounts {
+		if mount.MountPath == path {
+			return true
+		}
+	}
+	return false
+}
+
 func sanitizeVolumeName(input string) string {
 	return strings.ReplaceAll(input, ".", "")
 }
@@ -423,6 +506,28 @@ func addTrustedCABundle(collector *v1.Container, podSpec *v1.PodSpec, trustedCAB
 	}
 }
 
+// receiverTokenEnvVars returns the environment variables holding the bearer tokens of the receiver inputs, sorted by name
+func receiverTokenEnvVars(inputs internalobs.Inputs) (envVars []v1.EnvVar) {
+	tokens := inputs.ReceiverTokens()
+	names := make([]string, 0, len(tokens))
+	for name := range tokens {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		envVars = append(envVars, v1.EnvVar{
+			Name: name,
+			ValueFrom: &v1.EnvVarSource{
+				SecretKeyRef: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: tokens[name].SecretName},
+					Key:                  tokens[name].Key,
+				},
+			},
+		})
+	}
+	return envVars
+}
+
 func hasTrustedCABundle(configMap *v1.ConfigMap) (string, bool) {
 	if configMap == nil {
 		return "", false
@@ -18,6 +18,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/utils"
 	. "github.com/openshift/cluster-logging-operator/test/matchers"
 
+	apps "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -435,6 +436,26 @@ var _ = Describe("Factory#Daemonset", func() {
 					Expect(podSpec.Volumes).To(HaveLen(16))
 				})
 
+				It("should mount the directories of host file inputs read-only", func() {
+					podSpec = *factory.NewPodSpec(nil, obs.ClusterLogForwarderSpec{
+						Inputs: []obs.InputSpec{
+							{Name: "audit", Type: obs.InputTypeAudit, Audit: &obs.Audit{Sources: []obs.AuditSource{obs.AuditSourceAuditd}}},
+							{Name: "vendor", Type: obs.InputTypeHostFile, HostFile: &obs.HostFileInput{
+								Paths: []string{"/var/log/acme/*.log", "/var/log/audit/acme.log"},
+							}},
+						},
+					}, "1234", tls.GetClusterTLSProfileSpec(nil), constants.OpenshiftNS)
+					collector = podSpec.Containers[0]
+					Expect(podSpec.Volumes).To(IncludeVolume(v1.Volume{
+						Name: "hostfile-0",
+						VolumeSource: v1.VolumeSource{
+							HostPath: &v1.HostPathVolumeSource{
+								Path: "/var/log/acme"}}}))
+					Expect(collector.VolumeMounts).To(IncludeVolumeMount(v1.VolumeMount{Name: "hostfile-0", ReadOnly: true, MountPath: "/var/log/acme"}))
+					Expect(collector.VolumeMounts).To(IncludeVolumeMount(v1.VolumeMount{Name: sourceAuditdName, ReadOnly: true, MountPath: sourceAuditdPath}))
+					Expect(collector.VolumeMounts).ToNot(IncludeVolumeMount(v1.VolumeMount{Name: "hostfile-1", ReadOnly: true, MountPath: sourceAuditdPath}))
+				})
+
 				It("should mount all volumes for output configmaps", func() {
 					Expect(podSpec.Volumes).To(IncludeVolume(
 						v1.Volume{
@@ -533,6 +554,29 @@ var _ = Describe("Factory#Deployment", func() {
 						FieldRef: &v1.ObjectFieldSelector{
 							APIVersion: "v1", FieldPath: "status.podIP"}}}))
 			})
+			It("should provide the bearer token of a receiver as an environment var from its secret", func() {
+				podSpec = *factory.NewPodSpec(nil, obs.ClusterLogForwarderSpec{
+					Inputs: []obs.InputSpec{
+						{
+							Name: "my-receiver",
+							Type: obs.InputTypeReceiver,
+							Receiver: &obs.ReceiverSpec{
+								Type: obs.ReceiverTypeHTTP,
+								Port: 8443,
+								Authentication: &obs.ReceiverAuthentication{
+									Token: &obs.SecretReference{Key: "token", SecretName: "receiver-tokens"},
+								},
+							},
+						},
+					},
+				}, "1234", tls.GetClusterTLSProfileSpec(nil), constants.OpenshiftNS)
+				Expect(podSpec.Containers[0].Env).To(IncludeEnvVar(v1.EnvVar{Name: "RECEIVER_TOKEN_MY_RECEIVER",
+					ValueFrom: &v1.EnvVarSource{
+						SecretKeyRef: &v1.SecretKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{Name: "receiver-tokens"},
+							Key:                  "token",
+						}}}))
+			})
 			It("should not set security context", func() {
 				Expect(collector.SecurityContext).ToNot(Equal(&v1.SecurityContext{
 					Capabilities: &v1.Capabilities{
@@ -760,6 +804,36 @@ var _ = Describe("Factory#Deployment", func() {
 			Expect(actDpl.Spec.Template.Annotations).To(HaveKey(constants.AnnotationConfigMapHash))
 			Expect(actDpl.Spec.Template.Annotations).To(HaveKey(targetAnnotation))
 		})
+		It("should deploy two replicas by default", func() {
+			actDpl := *factory.NewDeployment(constants.OpenshiftNS, "test", nil, tls.GetClusterTLSProfileSpec(nil))
+			Expect(*actDpl.Spec.Replicas).To(Equal(int32(2)))
+		})
+		It("should deploy a single replica recreated on changes when collecting events", func() {
+			factory.ForwarderSpec.Inputs = []obs.InputSpec{
+				{Name: "events", Type: obs.InputTypeEvents, Events: &obs.EventsInput{}},
+			}
+			actDpl := *factory.NewDeployment(constants.OpenshiftNS, "test", nil, tls.GetClusterTLSProfileSpec(nil))
+			Expect(*actDpl.Spec.Replicas).To(Equal(int32(1)))
+			Expect(actDpl.Spec.Strategy.Type).To(Equal(apps.RecreateDeploymentStrategyType))
+		})
+		It("should add the events watcher posting to the collector when collecting events", func() {
+			factory.ForwarderSpec.Inputs = []obs.InputSpec{
+				{Name: "events", Type: obs.InputTypeEvents, Events: &obs.EventsInput{}},
+			}
+			actDpl := *factory.NewDeployment(constants.OpenshiftNS, "test", nil, tls.GetClusterTLSProfileSpec(nil))
+			containers := actDpl.Spec.Template.Spec.Containers
+			Expect(containers).To(HaveLen(2))
+			Expect(containers[1].Name).To(Equal(constants.EventsWatcherName))
+			Expect(containers[1].Args).To(Equal([]string{
+				"--namespace=" + constants.OpenshiftNS,
+				"--checkpoint=" + factory.ResourceNames.EventsCheckpoint,
+				"--endpoint=http://127.0.0.1:24232",
+			}))
+		})
+		It("should not add the events watcher without an events input", func() {
+			actDpl := *factory.NewDeployment(constants.OpenshiftNS, "test", nil, tls.GetClusterTLSProfileSpec(nil))
+			Expect(actDpl.Spec.Template.Spec.Containers).To(HaveLen(1))
+		})
 	})
 
 })
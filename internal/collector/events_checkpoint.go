@@ -0,0 +1,38 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// ReconcileEventsCheckpointConfigMap creates the ConfigMap where the events watcher saves the resource version of
+// the last forwarded event. The data is owned by the events watcher and is never modified
+func ReconcileEventsCheckpointConfigMap(k8sClient client.Client, namespace, name string, owner metav1.OwnerReference) error {
+	cm := runtime.NewConfigMap(namespace, name, nil)
+	op, err := controllerutil.CreateOrUpdate(context.TODO(), k8sClient, cm, func() error {
+		cm.OwnerReferences = []metav1.OwnerReference{owner}
+		return nil
+	})
+
+	if err == nil {
+		log.V(3).Info(fmt.Sprintf("reconciled events checkpoint ConfigMap - operation: %s", op))
+	}
+	return err
+}
+
+// RemoveEventsCheckpointConfigMap deletes the events checkpoint ConfigMap
+func RemoveEventsCheckpointConfigMap(k8sClient client.Client, namespace, name string) (err error) {
+	log.V(3).Info("Removing events checkpoint", "namespace", namespace, "name", name)
+	cm := runtime.NewConfigMap(namespace, name, nil)
+	if err = k8sClient.Delete(context.TODO(), cm); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failure deleting configmap %s/%s: %v", namespace, name, err)
+	}
+	return nil
+}
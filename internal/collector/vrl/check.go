@@ -0,0 +1,286 @@
+package vrl
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/transform"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/set"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ResultsOption is the key of the Results in the additional context of the reconciliation
+	ResultsOption = "transformCompileResults"
+
+	// ComponentName is the component label of the pods checking the programs of transform filters
+	ComponentName = "vrl-check"
+
+	// AnnotationConfig holds the configuration the check pod validates
+	AnnotationConfig = "observability.openshift.io/vrl-check-config"
+
+	configVolumeName = "config"
+	configPath       = "/etc/vector"
+	configFile       = "vector.toml"
+	tmpVolumeName    = "tmp"
+	tmpPath          = "/tmp"
+
+	// deadlineSeconds bounds the time a check pod is scheduled and runs before it is failed
+	deadlineSeconds = 120
+
+	// maxAttempts bounds the pods checking a program that fail without checking it
+	maxAttempts = 3
+
+	// initialBackoff is the delay before the check following the first failed attempt. It doubles on each attempt
+	initialBackoff = 30 * time.Second
+
+	// pollInterval is the time between the evaluations of a pending check pod
+	pollInterval = 10 * time.Second
+
+	// exitCodeConfig is the exit code of `vector validate` for a configuration that does not load
+	exitCodeConfig = 78
+)
+
+// Result is the outcome of compiling the program of a transform filter with the collector
+type Result struct {
+	// Pending is true until the collector completes the check
+	Pending bool
+
+	// Error is the reason the program was rejected
+	Error string
+
+	// RetryAfter is the time to wait before the check is evaluated again. It is zero when the check is complete
+	RetryAfter time.Duration
+}
+
+// Compiled is true when the collector compiled the program
+func (r Result) Compiled() bool {
+	return !r.Pending && r.Error == ""
+}
+
+// Results are the outcomes of compiling the programs keyed by the name of the transform filter
+type Results map[string]Result
+
+// RetryAfter is the shortest time to wait before one of the checks is evaluated again, or zero when all are complete
+func (r Results) RetryAfter() (after time.Duration) {
+	for _, result := range r {
+		if result.RetryAfter > 0 && (after == 0 || result.RetryAfter < after) {
+			after = result.RetryAfter
+		}
+	}
+	return after
+}
+
+// Check compiles the program of each transform filter with `vector validate` in a pod running the collector image
+// and returns the results keyed by the name of the filter. A pod is created once per distinct program and is kept until
+// the program is no longer spec'd, so the check is not repeated on every reconciliation. A pod that failed without
+// checking the program is retried by a new pod after a backoff, up to maxAttempts pods. Failures to manage the pods are
+// reported in the result of the filter. Programs that fail the syntax check are not compiled
+func Check(k8sClient client.Client, reader client.Reader, forwarder obs.ClusterLogForwarder, owner metav1.OwnerReference) Results {
+	results := Results{}
+	configs := map[string]string{}
+	for _, filter := range forwarder.Spec.Filters {
+		if filter.Type != obs.FilterTypeTransform {
+			continue
+		}
+		if config, err := transform.CheckConfig(filter.TransformFilterSpec); err == nil {
+			configs[filter.Name] = config
+		}
+	}
+
+	pods := &corev1.PodList{}
+	selector := runtime.Selectors(forwarder.Name, ComponentName, constants.VectorName)
+	if err := reader.List(context.TODO(), pods, client.InNamespace(forwarder.Namespace), client.MatchingLabels(selector)); err != nil {
+		for name := range configs {
+			results[name] = retryResult(fmt.Sprintf("failure listing VRL check pods: %v", err), backoff(0))
+		}
+		return results
+	}
+	current := map[string]*corev1.Pod{}
+	for i := range pods.Items {
+		current[pods.Items[i].Name] = &pods.Items[i]
+	}
+
+	desired := set.New[string]()
+	for name, config := range configs {
+		for attempt := 0; ; attempt++ {
+			pod := NewPod(forwarder, config, attempt)
+			desired.Insert(pod.Name)
+			existing, found := current[pod.Name]
+			if !found {
+				utils.AddOwnerRefToObject(pod, owner)
+				log.V(3).Info("Creating VRL check pod", "namespace", pod.Namespace, "name", pod.Name, "filter", name)
+				if err := k8sClient.Create(context.TODO(), pod); err != nil && !errors.IsAlreadyExists(err) {
+					results[name] = retryResult(fmt.Sprintf("failure creating pod %s/%s: %v", pod.Namespace, pod.Name, err), backoff(attempt))
+					break
+				}
+				results[name] = Result{Pending: true, RetryAfter: pollInterval}
+				break
+			}
+			result, retry := Evaluate(existing)
+			if !retry {
+				results[name] = result
+				break
+			}
+			if attempt+1 >= maxAttempts {
+				results[name] = Result{Error: fmt.Sprintf("the collector could not compile the program after %d attempts: %s", maxAttempts, result.Error)}
+				break
+			}
+			if wait := backoff(attempt) - time.Since(failedAt(existing)); wait > 0 {
+				results[name] = retryResult(result.Error, wait)
+				break
+			}
+		}
+	}
+
+	for name, pod := range current {
+		if desired.Has(name) {
+			continue
+		}
+		log.V(3).Info("Removing VRL check pod", "namespace", pod.Namespace, "name", pod.Name)
+		if err := k8sClient.Delete(context.TODO(), pod); err != nil && !errors.IsNotFound(err) {
+			log.V(0).Error(err, "Unable to remove VRL check pod", "namespace", pod.Namespace, "name", pod.Name)
+		}
+	}
+	return results
+}
+
+// retryResult is the result of a check that is retried after a delay
+func retryResult(reason string, after time.Duration) Result {
+	return Result{
+		Error:      fmt.Sprintf("the collector could not compile the program and will retry: %s", reason),
+		RetryAfter: after,
+	}
+}
+
+// backoff is the delay before the check following a failed attempt
+func backoff(attempt int) time.Duration {
+	return initialBackoff << attempt
+}
+
+// failedAt is the time a check pod failed
+func failedAt(pod *corev1.Pod) time.Time {
+	for _, status := range pod.Status.ContainerStatuses {
+		if terminated := status.State.Terminated; terminated != nil {
+			return terminated.FinishedAt.Time
+		}
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodScheduled {
+			return condition.LastTransitionTime.Time
+		}
+	}
+	return pod.CreationTimestamp.Time
+}
+
+// Evaluate returns the result of a check pod and whether the pod failed without checking the program. The error of a
+// pod to retry is the reason it failed
+func Evaluate(pod *corev1.Pod) (result Result, retry bool) {
+	switch pod.Status.Phase {
+	case corev1.PodSucceeded:
+		return Result{}, false
+	case corev1.PodFailed:
+		for _, status := range pod.Status.ContainerStatuses {
+			if terminated := status.State.Terminated; terminated != nil && terminated.ExitCode == exitCodeConfig {
+				return Result{Error: strings.TrimSpace(terminated.Message)}, false
+			}
+		}
+		reason := strings.TrimSpace(strings.Join([]string{pod.Status.Reason, pod.Status.Message}, " "))
+		if reason == "" {
+			reason = "the check pod failed"
+		}
+		return Result{Error: reason}, true
+	}
+	// the deadline of a pod only starts once it is scheduled
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionFalse &&
+			time.Since(condition.LastTransitionTime.Time) > deadlineSeconds*time.Second {
+			return Result{Error: strings.TrimSpace(fmt.Sprintf("the check pod was not scheduled: %s", condition.Message))}, true
+		}
+	}
+	return Result{Pending: true, RetryAfter: pollInterval}, false
+}
+
+// NewPod returns the pod that validates a collector configuration on the nodes of the collector of a forwarder. The
+// name is derived from the configuration and the attempt
+func NewPod(forwarder obs.ClusterLogForwarder, config string, attempt int) *corev1.Pod {
+	hash := fmt.Sprintf("%x", sha256.Sum256([]byte(config)))
+	name := fmt.Sprintf("%s-vrl-%s-%d", forwarder.Name, hash[:10], attempt)
+	container := runtime.NewContainer(ComponentName, utils.GetComponentImage(constants.VectorName), corev1.PullIfNotPresent, &corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("10m"),
+			corev1.ResourceMemory: resource.MustParse("64Mi"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("500m"),
+			corev1.ResourceMemory: resource.MustParse("256Mi"),
+		},
+	})
+	container.Command = []string{"/usr/bin/vector"}
+	container.Args = []string{"--color", "never", "validate", "--skip-healthchecks", "--config-toml", configPath + "/" + configFile}
+	container.TerminationMessagePolicy = corev1.TerminationMessageFallbackToLogsOnError
+	// the environment checks of `vector validate` create a data directory in the temporary directory
+	container.VolumeMounts = []corev1.VolumeMount{
+		{Name: configVolumeName, MountPath: configPath, ReadOnly: true},
+		{Name: tmpVolumeName, MountPath: tmpPath},
+	}
+	container.SecurityContext = &corev1.SecurityContext{
+		AllowPrivilegeEscalation: utils.GetPtr(false),
+		RunAsNonRoot:             utils.GetPtr(true),
+		ReadOnlyRootFilesystem:   utils.GetPtr(true),
+		Capabilities: &corev1.Capabilities{
+			Drop: []corev1.Capability{"ALL"},
+		},
+		SeccompProfile: &corev1.SeccompProfile{
+			Type: corev1.SeccompProfileTypeRuntimeDefault,
+		},
+	}
+
+	pod := runtime.NewPod(forwarder.Namespace, name, *container)
+	runtime.SetCommonLabels(pod, constants.VectorName, forwarder.Name, ComponentName)
+	pod.Annotations = map[string]string{AnnotationConfig: config}
+	pod.Spec.RestartPolicy = corev1.RestartPolicyNever
+	pod.Spec.ActiveDeadlineSeconds = utils.GetPtr[int64](deadlineSeconds)
+	// the check runs on the nodes of the collector
+	collector := obs.CollectorSpec{}
+	if forwarder.Spec.Collector != nil {
+		collector = *forwarder.Spec.Collector
+	}
+	pod.Spec.NodeSelector = utils.EnsureLinuxNodeSelector(collector.NodeSelector)
+	pod.Spec.Tolerations = append(constants.DefaultTolerations(), collector.Tolerations...)
+	pod.Spec.AutomountServiceAccountToken = utils.GetPtr(false)
+	pod.Spec.Volumes = []corev1.Volume{
+		{
+			Name: configVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				DownwardAPI: &corev1.DownwardAPIVolumeSource{
+					Items: []corev1.DownwardAPIVolumeFile{
+						{
+							Path:     configFile,
+							FieldRef: &corev1.ObjectFieldSelector{FieldPath: fmt.Sprintf("metadata.annotations['%s']", AnnotationConfig)},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: tmpVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		},
+	}
+	return pod
+}
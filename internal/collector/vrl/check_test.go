@@ -0,0 +1,178 @@
+package vrl
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/transform"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	obsruntime "github.com/openshift/cluster-logging-operator/internal/runtime/observability"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+var _ = Describe("#Check", func() {
+	var (
+		k8sClient client.Client
+		forwarder *obs.ClusterLogForwarder
+
+		checkPod = func(source string, attempt int) *corev1.Pod {
+			config, err := transform.CheckConfig(&obs.TransformFilterSpec{Source: source})
+			Expect(err).ToNot(HaveOccurred())
+			return NewPod(*forwarder, config, attempt)
+		}
+		setStatus = func(pod *corev1.Pod, status corev1.PodStatus) {
+			Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+			pod.Status = status
+			Expect(k8sClient.Status().Update(context.TODO(), pod)).To(Succeed())
+		}
+		check = func() Results {
+			return Check(k8sClient, k8sClient, *forwarder, utils.AsOwner(forwarder))
+		}
+	)
+
+	BeforeEach(func() {
+		k8sClient = fake.NewFakeClient()
+		forwarder = obsruntime.NewClusterLogForwarder(constants.OpenshiftNS, "my-forwarder", runtime.Initialize, func(clf *obs.ClusterLogForwarder) {
+			clf.Spec.Filters = []obs.FilterSpec{
+				{Name: "valid", Type: obs.FilterTypeTransform, TransformFilterSpec: &obs.TransformFilterSpec{Source: `.foo = "bar"`}},
+				{Name: "syntax", Type: obs.FilterTypeTransform, TransformFilterSpec: &obs.TransformFilterSpec{Source: `.foo = (`}},
+				{Name: "other", Type: obs.FilterTypeDetectMultiline},
+			}
+		})
+	})
+
+	It("should create a check pod for each program that passes the syntax check and report it pending", func() {
+		Expect(check()).To(Equal(Results{"valid": {Pending: true, RetryAfter: pollInterval}}))
+
+		pods := &corev1.PodList{}
+		Expect(k8sClient.List(context.TODO(), pods)).To(Succeed())
+		Expect(pods.Items).To(HaveLen(1))
+		Expect(pods.Items[0].Name).To(Equal(checkPod(`.foo = "bar"`, 0).Name))
+		Expect(pods.Items[0].OwnerReferences).To(ConsistOf(utils.AsOwner(forwarder)))
+	})
+
+	It("should report the program compiled when the check pod succeeds", func() {
+		check()
+		setStatus(checkPod(`.foo = "bar"`, 0), corev1.PodStatus{Phase: corev1.PodSucceeded})
+		results := check()
+		Expect(results).To(Equal(Results{"valid": {}}))
+		Expect(results["valid"].Compiled()).To(BeTrue())
+	})
+
+	It("should report the compiler output when the collector rejects the program", func() {
+		check()
+		setStatus(checkPod(`.foo = "bar"`, 0), corev1.PodStatus{
+			Phase: corev1.PodFailed,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 78, Message: "error[E105]: call to undefined function\n"}}},
+			},
+		})
+		Expect(check()).To(Equal(Results{"valid": {Error: "error[E105]: call to undefined function"}}))
+		Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(checkPod(`.foo = "bar"`, 0)), &corev1.Pod{})).To(Succeed())
+	})
+
+	It("should retry a check pod that failed without checking the program with a new pod", func() {
+		check()
+		setStatus(checkPod(`.foo = "bar"`, 0), corev1.PodStatus{Phase: corev1.PodFailed, Reason: "DeadlineExceeded"})
+		Expect(check()).To(Equal(Results{"valid": {Pending: true, RetryAfter: pollInterval}}))
+		Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(checkPod(`.foo = "bar"`, 0)), &corev1.Pod{})).To(Succeed())
+		Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(checkPod(`.foo = "bar"`, 1)), &corev1.Pod{})).To(Succeed())
+	})
+
+	It("should back off before retrying a check pod that failed recently", func() {
+		check()
+		setStatus(checkPod(`.foo = "bar"`, 0), corev1.PodStatus{
+			Phase: corev1.PodFailed,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled", FinishedAt: metav1.Now()}}},
+			},
+			Reason: "OOMKilled",
+		})
+		results := check()
+		Expect(results["valid"].Error).To(ContainSubstring("will retry: OOMKilled"))
+		Expect(results["valid"].RetryAfter).To(BeNumerically(">", 0))
+		Expect(results["valid"].RetryAfter).To(BeNumerically("<=", initialBackoff))
+		Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(checkPod(`.foo = "bar"`, 1)), &corev1.Pod{})).ToNot(Succeed())
+	})
+
+	It("should stop retrying after the last attempt", func() {
+		for attempt := 0; attempt < maxAttempts; attempt++ {
+			check()
+			setStatus(checkPod(`.foo = "bar"`, attempt), corev1.PodStatus{Phase: corev1.PodFailed, Reason: "DeadlineExceeded"})
+		}
+		results := check()
+		Expect(results["valid"].Error).To(Equal("the collector could not compile the program after 3 attempts: DeadlineExceeded"))
+		Expect(results["valid"].RetryAfter).To(BeZero())
+
+		pods := &corev1.PodList{}
+		Expect(k8sClient.List(context.TODO(), pods)).To(Succeed())
+		Expect(pods.Items).To(HaveLen(maxAttempts))
+	})
+
+	It("should report the failure to create a check pod in the result", func() {
+		k8sClient = interceptor.NewClient(fake.NewFakeClient().(client.WithWatch), interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				return fmt.Errorf("forbidden")
+			},
+		})
+		results := check()
+		Expect(results["valid"].Error).To(Equal("the collector could not compile the program and will retry: failure creating pod " +
+			forwarder.Namespace + "/" + checkPod(`.foo = "bar"`, 0).Name + ": forbidden"))
+		Expect(results["valid"].RetryAfter).To(Equal(initialBackoff))
+	})
+
+	It("should remove the check pods of programs that are no longer spec'd", func() {
+		check()
+		forwarder.Spec.Filters[0].TransformFilterSpec.Source = `.foo = "baz"`
+		check()
+
+		pods := &corev1.PodList{}
+		Expect(k8sClient.List(context.TODO(), pods)).To(Succeed())
+		Expect(pods.Items).To(HaveLen(1))
+		Expect(pods.Items[0].Name).To(Equal(checkPod(`.foo = "baz"`, 0).Name))
+	})
+})
+
+var _ = Describe("#NewPod", func() {
+	It("should validate the configuration with the collector", func() {
+		forwarder := obsruntime.NewClusterLogForwarder(constants.OpenshiftNS, "my-forwarder", runtime.Initialize)
+		pod := NewPod(*forwarder, "some config", 0)
+		Expect(pod.Name).To(MatchRegexp(`^my-forwarder-vrl-[0-9a-f]{10}-0$`))
+		Expect(pod.Annotations).To(HaveKeyWithValue(AnnotationConfig, "some config"))
+		Expect(pod.Labels).To(HaveKeyWithValue(constants.LabelK8sComponent, ComponentName))
+		Expect(pod.Spec.RestartPolicy).To(Equal(corev1.RestartPolicyNever))
+		Expect(pod.Spec.Containers).To(HaveLen(1))
+		Expect(pod.Spec.Containers[0].Command).To(Equal([]string{"/usr/bin/vector"}))
+		Expect(pod.Spec.Containers[0].Args).To(Equal([]string{"--color", "never", "validate", "--skip-healthchecks", "--config-toml", "/etc/vector/vector.toml"}))
+		Expect(pod.Spec.Containers[0].TerminationMessagePolicy).To(Equal(corev1.TerminationMessageFallbackToLogsOnError))
+		Expect(pod.Spec.Containers[0].Resources.Limits).To(HaveKey(corev1.ResourceMemory))
+		Expect(pod.Spec.Containers[0].Resources.Limits).To(HaveKey(corev1.ResourceCPU))
+	})
+	It("should name the pod after the configuration and the attempt", func() {
+		forwarder := obsruntime.NewClusterLogForwarder("ns", "clf", runtime.Initialize)
+		Expect(NewPod(*forwarder, "a", 0).Name).ToNot(Equal(NewPod(*forwarder, "b", 0).Name))
+		Expect(NewPod(*forwarder, "a", 0).Name).ToNot(Equal(NewPod(*forwarder, "a", 1).Name))
+		Expect(NewPod(*forwarder, "a", 0).Name).To(Equal(NewPod(*forwarder, "a", 0).Name))
+	})
+	It("should schedule the pod on the nodes of the collector", func() {
+		toleration := corev1.Toleration{Key: "node-role.kubernetes.io/infra", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule}
+		forwarder := obsruntime.NewClusterLogForwarder("ns", "clf", runtime.Initialize, func(clf *obs.ClusterLogForwarder) {
+			clf.Spec.Collector = &obs.CollectorSpec{
+				NodeSelector: map[string]string{"node-role.kubernetes.io/infra": ""},
+				Tolerations:  []corev1.Toleration{toleration},
+			}
+		})
+		pod := NewPod(*forwarder, "a", 0)
+		Expect(pod.Spec.NodeSelector).To(Equal(map[string]string{"node-role.kubernetes.io/infra": "", utils.OsNodeLabel: utils.LinuxValue}))
+		Expect(pod.Spec.Tolerations).To(Equal(append(constants.DefaultTolerations(), toleration)))
+	})
+})
@@ -0,0 +1,13 @@
+package vrl
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestVRLCheck(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "[internal][collector][vrl] suite")
+}
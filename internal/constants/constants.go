@@ -48,6 +48,8 @@ const (
 	KibanaName                        = "kibana"
 	LogfilesmetricexporterName        = "logfilesmetricexporter"
 	LogfilesmetricexporterPort        = int32(2112)
+	EventsWatcherName                 = "events-watcher"
+	EventsWatcherPort                 = int32(24232)
 	MetricsPortName                   = "metrics"
 	MetricsPort                       = int32(24231)
 	MetricsCollectionProfileFull      = "full"
@@ -67,6 +69,7 @@ const (
 	VectorImageEnvVar         = "RELATED_IMAGE_VECTOR"
 	VectorReceiverImageEnvVar = "IMAGE_VECTOR_RECEIVER"
 	LogfilesmetricImageEnvVar = "RELATED_IMAGE_LOG_FILE_METRIC_EXPORTER"
+	EventsWatcherImageEnvVar  = "RELATED_IMAGE_EVENTS_WATCHER"
 
 	ContainerLogDir = "/var/log/containers"
 	PodLogDir       = "/var/log/pods"
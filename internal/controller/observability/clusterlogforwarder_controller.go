@@ -22,6 +22,7 @@ import (
 	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
 	"github.com/openshift/cluster-logging-operator/internal/auth"
 	"github.com/openshift/cluster-logging-operator/internal/collector"
+	"github.com/openshift/cluster-logging-operator/internal/collector/vrl"
 	"github.com/openshift/cluster-logging-operator/internal/factory"
 	"github.com/openshift/cluster-logging-operator/internal/tls"
 	"github.com/openshift/cluster-logging-operator/internal/utils"
@@ -113,6 +114,9 @@ func (r *ClusterLogForwarderReconciler) Reconcile(_ context.Context, req ctrl.Re
 				log.V(0).Error(deleteErr, "Unable to remove collector deployment")
 			}
 		}
+		if after := compileRetryAfter(cxt); after > 0 {
+			return ctrl.Result{RequeueAfter: after}, nil
+		}
 		return defaultRequeue, err
 	}
 
@@ -148,13 +152,17 @@ func RemoveStaleWorkload(k8Client client.Client, forwarder *obsv1.ClusterLogForw
 // cleanupClusterScopedResources removes cluster-scoped resources that cannot be garbage collected
 func cleanupClusterScopedResources(context internalcontext.ForwarderContext) error {
 	resourceNames := factory.ResourceNames(*context.Forwarder)
-	// Delete the metrics auth ClusterRoleBinding (NotFound errors are ignored by DeleteMetricsAuthRBAC)
-	return auth.DeleteMetricsAuthRBAC(context.Client, resourceNames.MetricsAuthClusterRoleBinding)
+	// Delete the metrics auth and events reader ClusterRoleBindings (NotFound errors are ignored)
+	if err := auth.DeleteMetricsAuthRBAC(context.Client, resourceNames.MetricsAuthClusterRoleBinding); err != nil {
+		return err
+	}
+	return auth.DeleteEventsReaderRBAC(context.Client, resourceNames.EventsReaderClusterRoleBinding)
 }
 
-func MapSecrets(k8Client client.Client, namespace string, inputs internalobs.Inputs, outputs internalobs.Outputs) (secretMap map[string]*corev1.Secret, err error) {
+func MapSecrets(k8Client client.Client, namespace string, inputs internalobs.Inputs, outputs internalobs.Outputs, filters internalobs.Filters) (secretMap map[string]*corev1.Secret, err error) {
 	names := set.New(inputs.SecretNames()...)
 	names.Insert(outputs.SecretNames()...)
+	names.Insert(filters.SecretNames()...)
 	log.WithName(loggerName).V(4).Info("MapSecrets", "names", names.SortedList())
 	secretMap = map[string]*corev1.Secret{}
 	var secrets []*corev1.Secret
@@ -168,9 +176,10 @@ func MapSecrets(k8Client client.Client, namespace string, inputs internalobs.Inp
 	return secretMap, nil
 }
 
-func MapConfigMaps(k8Client client.Client, namespace string, inputs internalobs.Inputs, outputs internalobs.Outputs) (configMaps map[string]*corev1.ConfigMap, err error) {
+func MapConfigMaps(k8Client client.Client, namespace string, inputs internalobs.Inputs, outputs internalobs.Outputs, filters internalobs.Filters) (configMaps map[string]*corev1.ConfigMap, err error) {
 	names := set.New(inputs.ConfigmapNames()...)
 	names.Insert(outputs.ConfigmapNames()...)
+	names.Insert(filters.ConfigmapNames()...)
 	log.WithName(loggerName).V(4).Info("MapConfigMaps", "names", names.SortedList())
 	configMaps = map[string]*corev1.ConfigMap{}
 	var configs []*corev1.ConfigMap
@@ -193,7 +202,7 @@ func initialize(cxt internalcontext.ForwarderContext) (internalcontext.Forwarder
 	migrated := internalinit.ClusterLogForwarder(*cxt.Forwarder, cxt.AdditionalContext)
 	cxt.Forwarder = &migrated
 
-	if cxt.Secrets, err = MapSecrets(cxt.Client, cxt.Forwarder.Namespace, cxt.Forwarder.Spec.Inputs, cxt.Forwarder.Spec.Outputs); err != nil {
+	if cxt.Secrets, err = MapSecrets(cxt.Client, cxt.Forwarder.Namespace, cxt.Forwarder.Spec.Inputs, cxt.Forwarder.Spec.Outputs, cxt.Forwarder.Spec.Filters); err != nil {
 		return cxt, err
 	}
 
@@ -203,10 +212,12 @@ func initialize(cxt internalcontext.ForwarderContext) (internalcontext.Forwarder
 		}
 	}
 
-	if cxt.ConfigMaps, err = MapConfigMaps(cxt.Client, cxt.Forwarder.Namespace, cxt.Forwarder.Spec.Inputs, cxt.Forwarder.Spec.Outputs); err != nil {
+	if cxt.ConfigMaps, err = MapConfigMaps(cxt.Client, cxt.Forwarder.Namespace, cxt.Forwarder.Spec.Inputs, cxt.Forwarder.Spec.Outputs, cxt.Forwarder.Spec.Filters); err != nil {
 		return cxt, err
 	}
 
+	cxt.AdditionalContext[vrl.ResultsOption] = vrl.Check(cxt.Client, cxt.Reader, *cxt.Forwarder, utils.AsOwner(cxt.Forwarder))
+
 	// Determine if on HCP and use the appropriate cluster Version/id
 	clusterVersion, clusterID := version.HostedClusterVersion(context.TODO(), cxt.Reader, cxt.Forwarder.Namespace)
 	if clusterVersion != "" && clusterID != "" {
@@ -276,6 +287,13 @@ func validateForwarder(forwarderContext internalcontext.ForwarderContext) (valid
 	return valid
 }
 
+// compileRetryAfter is the time to wait before the programs of transform filters that are not compiled yet are checked
+// again, or zero when all checks are complete
+func compileRetryAfter(cxt internalcontext.ForwarderContext) time.Duration {
+	results, _ := utils.GetOption(cxt.AdditionalContext, vrl.ResultsOption, vrl.Results{})
+	return results.RetryAfter()
+}
+
 func updateStatus(k8Client client.Client, instance *obsv1.ClusterLogForwarder, ready metav1.Condition) {
 	internalobs.SetCondition(&instance.Status.Conditions, ready)
 	jsonPatch, _ := json.Marshal(map[string]interface{}{
@@ -7,7 +7,9 @@ import (
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	obscontext "github.com/openshift/cluster-logging-operator/internal/api/context"
 	"github.com/openshift/cluster-logging-operator/internal/auth"
+	"github.com/openshift/cluster-logging-operator/internal/collector/vrl"
 	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
 	"github.com/openshift/cluster-logging-operator/internal/utils/json"
 	"github.com/openshift/cluster-logging-operator/test"
 	. "github.com/openshift/cluster-logging-operator/test/matchers"
@@ -131,4 +133,22 @@ spec:
 		})
 	})
 
+	Context("#compileRetryAfter", func() {
+		It("should be the shortest delay of the checks that are not complete", func() {
+			cxt := obscontext.ForwarderContext{AdditionalContext: utils.Options{
+				vrl.ResultsOption: vrl.Results{
+					"a": {},
+					"b": {Pending: true, RetryAfter: 10 * time.Second},
+					"c": {Error: "will retry", RetryAfter: 5 * time.Second},
+				},
+			}}
+			Expect(compileRetryAfter(cxt)).To(Equal(5 * time.Second))
+		})
+		It("should be zero when all checks are complete", func() {
+			cxt := obscontext.ForwarderContext{AdditionalContext: utils.Options{
+				vrl.ResultsOption: vrl.Results{"a": {}, "b": {Error: "error[E105]: call to undefined function"}},
+			}}
+			Expect(compileRetryAfter(cxt)).To(BeZero())
+		})
+	})
 })
@@ -18,6 +18,7 @@ import (
 	forwardergenerator "github.com/openshift/cluster-logging-operator/internal/generator/forwarder"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	generatorhelpers "github.com/openshift/cluster-logging-operator/internal/generator/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/enrichip"
 	"github.com/openshift/cluster-logging-operator/internal/metrics"
 	"github.com/openshift/cluster-logging-operator/internal/network"
 	"github.com/openshift/cluster-logging-operator/internal/reconcile"
@@ -46,6 +47,7 @@ func ReconcileCollector(context internalcontext.ForwarderContext, pollInterval,
 		options = context.AdditionalContext
 	}
 
+	inputs := internalobs.Inputs(context.Forwarder.Spec.Inputs)
 	if internalobs.Outputs(context.Forwarder.Spec.Outputs).NeedServiceAccountToken() {
 		// temporarily create SA token until collector is capable of dynamically reloading a projected serviceaccount token
 		var sa *corev1.ServiceAccount
@@ -75,6 +77,36 @@ func ReconcileCollector(context internalcontext.ForwarderContext, pollInterval,
 		return
 	}
 
+	// Add ClusterRoleBinding to allow the events watcher to watch the events of the cluster
+	if inputs.HasEventsSource() {
+		if err = auth.ReconcileEventsReaderRBAC(context.Client, resourceNames.EventsReaderClusterRoleBinding, context.Forwarder.Namespace, context.Forwarder.Spec.ServiceAccount.Name); err != nil {
+			log.V(3).Error(err, "auth.ReconcileEventsReaderRBAC")
+			return
+		}
+		// Add the checkpoint and the Role to allow the events watcher to save it
+		if err = collector.ReconcileEventsCheckpointConfigMap(context.Client, context.Forwarder.Namespace, resourceNames.EventsCheckpoint, ownerRef); err != nil {
+			log.V(3).Error(err, "collector.ReconcileEventsCheckpointConfigMap")
+			return
+		}
+		if err = auth.ReconcileEventsWatcherRBAC(context.Client, context.Forwarder.Namespace, resourceNames.EventsWatcher, resourceNames.EventsCheckpoint, context.Forwarder.Spec.ServiceAccount.Name, ownerRef); err != nil {
+			log.V(3).Error(err, "auth.ReconcileEventsWatcherRBAC")
+			return
+		}
+	} else {
+		if err = auth.DeleteEventsReaderRBAC(context.Client, resourceNames.EventsReaderClusterRoleBinding); err != nil {
+			log.V(3).Error(err, "auth.DeleteEventsReaderRBAC")
+			return
+		}
+		if err = auth.DeleteEventsWatcherRBAC(context.Client, context.Forwarder.Namespace, resourceNames.EventsWatcher); err != nil {
+			log.V(3).Error(err, "auth.DeleteEventsWatcherRBAC")
+			return
+		}
+		if err = collector.RemoveEventsCheckpointConfigMap(context.Client, context.Forwarder.Namespace, resourceNames.EventsCheckpoint); err != nil {
+			log.V(3).Error(err, "collector.RemoveEventsCheckpointConfigMap")
+			return
+		}
+	}
+
 	// TODO: This can be the same per NS but what is the ownerref?  Multiple CLFs will clash
 	if err = collector.ReconcileTrustedCABundleConfigMap(context.Client, context.Forwarder.Namespace, resourceNames.CaTrustBundle, ownerRef); err != nil {
 		log.Error(err, "collector.ReconcileTrustedCABundleConfigMap")
@@ -93,6 +125,8 @@ func ReconcileCollector(context internalcontext.ForwarderContext, pollInterval,
 		}
 	}
 
+	options[enrichip.PrefixesOption] = enrichip.TablePrefixes(context.Forwarder.Spec.Filters, context.Secrets, context.ConfigMaps)
+
 	var collectorConfig string
 	if collectorConfig, err = GenerateConfig(context.Client, *context.Forwarder, *resourceNames, context.Secrets, options); err != nil {
 		log.V(9).Error(err, "collector.GenerateConfig")
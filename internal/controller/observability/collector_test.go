@@ -219,6 +219,48 @@ var _ = Describe("Reconciling the Collector", func() {
 				}
 			}
 		})
+		It("should deploy the events watcher with its checkpoint and remove them with the events input", func() {
+			clf := obsruntime.NewClusterLogForwarder(namespaceName, clfName, runtime.Initialize, func(clf *obs.ClusterLogForwarder) {
+				clf.Spec = obs.ClusterLogForwarderSpec{
+					Inputs: []obs.InputSpec{
+						{
+							Name:   "myevents",
+							Type:   obs.InputTypeEvents,
+							Events: &obs.EventsInput{},
+						},
+					},
+					ServiceAccount: obs.ServiceAccount{
+						Name: saName,
+					},
+				}
+			})
+			beforeEach()
+			reconcileCollector(clf)
+
+			checkpointKey := types.NamespacedName{Name: resourceNames.EventsCheckpoint, Namespace: namespaceName}
+			checkpoint := &corev1.ConfigMap{}
+			Expect(client.Get(context.TODO(), checkpointKey, checkpoint)).Should(Succeed(), "Exp. to create the events checkpoint")
+			watcherKey := types.NamespacedName{Name: resourceNames.EventsWatcher, Namespace: namespaceName}
+			Expect(client.Get(context.TODO(), watcherKey, &rbacv1.Role{})).Should(Succeed(), "Exp. to create the events watcher Role")
+			Expect(client.Get(context.TODO(), watcherKey, &rbacv1.RoleBinding{})).Should(Succeed(), "Exp. to create the events watcher RoleBinding")
+			deployment := &appsv1.Deployment{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: clfName, Namespace: namespaceName}, deployment)).Should(Succeed())
+			Expect(deployment.Spec.Template.Spec.Containers).To(HaveLen(2))
+			Expect(deployment.Spec.Template.Spec.Containers[1].Name).To(Equal(constants.EventsWatcherName))
+
+			By("keeping the checkpoint saved by the events watcher")
+			checkpoint.Data = map[string]string{"resourceVersion": "12345"}
+			Expect(client.Update(context.TODO(), checkpoint)).Should(Succeed())
+			reconcileCollector(clf)
+			Expect(client.Get(context.TODO(), checkpointKey, checkpoint)).Should(Succeed())
+			Expect(checkpoint.Data).To(HaveKeyWithValue("resourceVersion", "12345"))
+
+			By("removing the events watcher resources without an events input")
+			reconcileCollector(receiverForwarder)
+			Expect(client.Get(context.TODO(), checkpointKey, &corev1.ConfigMap{})).To(MatchError(ContainSubstring("not found")))
+			Expect(client.Get(context.TODO(), watcherKey, &rbacv1.Role{})).To(MatchError(ContainSubstring("not found")))
+			Expect(client.Get(context.TODO(), watcherKey, &rbacv1.RoleBinding{})).To(MatchError(ContainSubstring("not found")))
+		})
 		DescribeTable("should deploy resources to support metrics collection", func(clf *obs.ClusterLogForwarder) {
 			beforeEach()
 			reconcileCollector(clf)
@@ -0,0 +1,13 @@
+package eventswatcher_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestEventsWatcher(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "[internal][eventswatcher] suite")
+}
@@ -0,0 +1,262 @@
+package eventswatcher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// CheckpointKey is the key of the checkpoint ConfigMap holding the resource version of the last forwarded event
+	CheckpointKey = "resourceVersion"
+
+	DefaultBatchSize      = 500
+	DefaultBatchWait      = time.Second
+	DefaultRetryWait      = 5 * time.Second
+	DefaultRequestTimeout = time.Minute
+)
+
+// Options configures a Watcher
+type Options struct {
+	// Namespace of the checkpoint ConfigMap
+	Namespace string
+
+	// CheckpointName is the name of the ConfigMap storing the resource version from which watching resumes
+	CheckpointName string
+
+	// Endpoint is the URL of the collector source receiving the events as newline delimited JSON
+	Endpoint string
+
+	// BatchSize is the maximum number of events posted in one request
+	BatchSize int
+
+	// BatchWait is the maximum time an event is buffered before it is posted
+	BatchWait time.Duration
+
+	// RetryWait is the time to wait before retrying a failed request
+	RetryWait time.Duration
+}
+
+// Watcher watches the events of the cluster and posts each added or modified event to the collector. The
+// resource version of the forwarded events is saved in a checkpoint once the collector accepted them so a
+// restarted watcher resumes where the previous one stopped instead of replaying the events
+type Watcher struct {
+	Options
+	client     kubernetes.Interface
+	httpClient *http.Client
+
+	// resourceVersion is the resource version of the checkpoint
+	resourceVersion string
+}
+
+// New returns a Watcher using the given client, defaulting the batching and retry options
+func New(client kubernetes.Interface, options Options) *Watcher {
+	if options.BatchSize <= 0 {
+		options.BatchSize = DefaultBatchSize
+	}
+	if options.BatchWait <= 0 {
+		options.BatchWait = DefaultBatchWait
+	}
+	if options.RetryWait <= 0 {
+		options.RetryWait = DefaultRetryWait
+	}
+	return &Watcher{
+		Options:    options,
+		client:     client,
+		httpClient: &http.Client{Timeout: DefaultRequestTimeout},
+	}
+}
+
+// Run forwards the events until the context is cancelled. Watching starts at the resource version of the
+// checkpoint or, without one, at the current resource version of the events
+func (w *Watcher) Run(ctx context.Context) error {
+	cm, err := w.client.CoreV1().ConfigMaps(w.Namespace).Get(ctx, w.CheckpointName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to read the checkpoint %s/%s: %w", w.Namespace, w.CheckpointName, err)
+	}
+	w.resourceVersion = cm.Data[CheckpointKey]
+	log.V(0).Info("Starting to watch events", "resourceVersion", w.resourceVersion)
+
+	for ctx.Err() == nil {
+		if w.resourceVersion == "" {
+			if err = w.resetCheckpoint(ctx); err != nil {
+				log.Error(err, "Failed to checkpoint the current resource version of the events")
+				_ = w.wait(ctx)
+				continue
+			}
+		}
+		err = w.watch(ctx)
+		switch {
+		case ctx.Err() != nil:
+		case apierrors.IsResourceExpired(err) || apierrors.IsGone(err):
+			log.V(0).Info("The checkpoint expired, the events since the checkpoint are not forwarded", "resourceVersion", w.resourceVersion)
+			w.resourceVersion = ""
+		case err != nil:
+			log.Error(err, "Failed to watch events", "resourceVersion", w.resourceVersion)
+			_ = w.wait(ctx)
+		}
+	}
+	return nil
+}
+
+// resetCheckpoint saves the current resource version of the events as the checkpoint
+func (w *Watcher) resetCheckpoint(ctx context.Context) error {
+	list, err := w.client.CoreV1().Events(metav1.NamespaceAll).List(ctx, metav1.ListOptions{Limit: 1})
+	if err != nil {
+		return err
+	}
+	if list.ResourceVersion == "" {
+		return fmt.Errorf("the event list has no resource version")
+	}
+	return w.saveCheckpoint(ctx, list.ResourceVersion)
+}
+
+// watch forwards the events from the checkpoint until the watch is closed or fails
+func (w *Watcher) watch(ctx context.Context) error {
+	watcher, err := w.client.CoreV1().Events(metav1.NamespaceAll).Watch(ctx, metav1.ListOptions{
+		ResourceVersion:     w.resourceVersion,
+		AllowWatchBookmarks: true,
+	})
+	if err != nil {
+		return err
+	}
+	defer watcher.Stop()
+
+	ticker := time.NewTicker(w.BatchWait)
+	defer ticker.Stop()
+
+	batch := &bytes.Buffer{}
+	size := 0
+	// resourceVersion is the checkpoint once the batch is forwarded
+	resourceVersion := w.resourceVersion
+	flush := func() error {
+		if size > 0 {
+			if err := w.post(ctx, batch.Bytes()); err != nil {
+				return err
+			}
+			batch.Reset()
+			size = 0
+		}
+		return w.saveCheckpoint(ctx, resourceVersion)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err = flush(); err != nil {
+				return err
+			}
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				// The API server closes watches periodically, watching resumes from the checkpoint
+				return flush()
+			}
+			switch event.Type {
+			case watch.Added, watch.Modified:
+				e, ok := event.Object.(*corev1.Event)
+				if !ok {
+					continue
+				}
+				line, err := json.Marshal(e)
+				if err != nil {
+					return err
+				}
+				batch.Write(line)
+				batch.WriteByte('\n')
+				size++
+				resourceVersion = e.ResourceVersion
+				if size >= w.BatchSize {
+					if err = flush(); err != nil {
+						return err
+					}
+				}
+			case watch.Bookmark:
+				if object, err := meta.Accessor(event.Object); err == nil {
+					resourceVersion = object.GetResourceVersion()
+				}
+			case watch.Error:
+				if err = flush(); err != nil {
+					return err
+				}
+				return apierrors.FromObject(event.Object)
+			}
+		}
+	}
+}
+
+// post sends the events to the collector, retrying until they are accepted or the context is cancelled
+func (w *Watcher) post(ctx context.Context, body []byte) error {
+	for {
+		err := w.send(ctx, body)
+		if err == nil {
+			return nil
+		}
+		log.Error(err, "Failed to forward events to the collector", "endpoint", w.Endpoint)
+		if err = w.wait(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+func (w *Watcher) send(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected response status: %s", resp.Status)
+	}
+	return nil
+}
+
+// saveCheckpoint stores the resource version in the checkpoint ConfigMap when it changed
+func (w *Watcher) saveCheckpoint(ctx context.Context, resourceVersion string) error {
+	if resourceVersion == w.resourceVersion {
+		return nil
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"data": map[string]string{CheckpointKey: resourceVersion},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err = w.client.CoreV1().ConfigMaps(w.Namespace).Patch(ctx, w.CheckpointName, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return err
+	}
+	w.resourceVersion = resourceVersion
+	return nil
+}
+
+// wait for the retry interval, returning early with an error when the context is cancelled
+func (w *Watcher) wait(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(w.RetryWait):
+		return nil
+	}
+}
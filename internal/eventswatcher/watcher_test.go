@@ -0,0 +1,197 @@
+package eventswatcher_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/cluster-logging-operator/internal/eventswatcher"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+var _ = Describe("Watcher", func() {
+	const (
+		namespace  = "openshift-logging"
+		checkpoint = "my-clf-events-checkpoint"
+	)
+
+	var (
+		client   *fake.Clientset
+		watchers chan *watch.FakeWatcher
+		versions chan string
+
+		mutex    sync.Mutex
+		status   int
+		received []string
+		server   *httptest.Server
+
+		cancel context.CancelFunc
+		done   chan struct{}
+	)
+
+	newEvent := func(name, resourceVersion string) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", ResourceVersion: resourceVersion},
+			Type:       corev1.EventTypeWarning,
+			Message:    "Back-off restarting failed container",
+		}
+	}
+
+	receivedLines := func() []string {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return received
+	}
+
+	checkpointOf := func() string {
+		cm, err := client.CoreV1().ConfigMaps(namespace).Get(context.TODO(), checkpoint, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return cm.Data[eventswatcher.CheckpointKey]
+	}
+
+	start := func(resourceVersion string) {
+		data := map[string]string{}
+		if resourceVersion != "" {
+			data[eventswatcher.CheckpointKey] = resourceVersion
+		}
+		client = fake.NewClientset(runtime.NewConfigMap(namespace, checkpoint, data))
+		client.PrependWatchReactor("events", func(action k8stesting.Action) (bool, watch.Interface, error) {
+			versions <- action.(k8stesting.WatchActionImpl).WatchRestrictions.ResourceVersion
+			w := watch.NewFake()
+			watchers <- w
+			return true, w, nil
+		})
+		client.PrependReactor("list", "events", func(action k8stesting.Action) (bool, apiruntime.Object, error) {
+			return true, &corev1.EventList{ListMeta: metav1.ListMeta{ResourceVersion: "500"}}, nil
+		})
+
+		w := eventswatcher.New(client, eventswatcher.Options{
+			Namespace:      namespace,
+			CheckpointName: checkpoint,
+			Endpoint:       server.URL,
+			BatchSize:      2,
+			BatchWait:      50 * time.Millisecond,
+			RetryWait:      10 * time.Millisecond,
+		})
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+		done = make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			defer close(done)
+			Expect(w.Run(ctx)).To(Succeed())
+		}()
+	}
+
+	BeforeEach(func() {
+		watchers = make(chan *watch.FakeWatcher, 10)
+		versions = make(chan string, 10)
+		received = nil
+		status = http.StatusOK
+		server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			body, _ := io.ReadAll(req.Body)
+			mutex.Lock()
+			defer mutex.Unlock()
+			if status == http.StatusOK {
+				received = append(received, strings.Split(strings.TrimSpace(string(body)), "\n")...)
+			}
+			rw.WriteHeader(status)
+		}))
+	})
+
+	AfterEach(func() {
+		cancel()
+		Eventually(done).Should(BeClosed())
+		server.Close()
+	})
+
+	It("should resume watching from the checkpoint and checkpoint the forwarded events", func() {
+		start("100")
+		Eventually(versions).Should(Receive(Equal("100")))
+		var w *watch.FakeWatcher
+		Eventually(watchers).Should(Receive(&w))
+
+		w.Add(newEvent("a", "101"))
+		w.Modify(newEvent("a", "102"))
+		w.Delete(newEvent("a", "103"))
+		w.Add(newEvent("b", "104"))
+
+		Eventually(receivedLines).Should(HaveLen(3))
+		Expect(receivedLines()[0]).To(ContainSubstring(`"name":"a","namespace":"default","resourceVersion":"101"`))
+		Expect(receivedLines()[1]).To(ContainSubstring(`"resourceVersion":"102"`))
+		Expect(receivedLines()[2]).To(ContainSubstring(`"name":"b","namespace":"default","resourceVersion":"104"`))
+		Eventually(checkpointOf).Should(Equal("104"))
+	})
+
+	It("should checkpoint the resource version of bookmarks", func() {
+		start("100")
+		var w *watch.FakeWatcher
+		Eventually(watchers).Should(Receive(&w))
+
+		w.Action(watch.Bookmark, &corev1.Event{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "200"}})
+
+		Eventually(checkpointOf).Should(Equal("200"))
+		Expect(receivedLines()).To(BeEmpty())
+	})
+
+	It("should not advance the checkpoint until the collector accepts the events", func() {
+		status = http.StatusServiceUnavailable
+		start("100")
+		var w *watch.FakeWatcher
+		Eventually(watchers).Should(Receive(&w))
+
+		w.Add(newEvent("a", "101"))
+		Consistently(checkpointOf, 200*time.Millisecond).Should(Equal("100"))
+
+		mutex.Lock()
+		status = http.StatusOK
+		mutex.Unlock()
+		Eventually(receivedLines).Should(HaveLen(1))
+		Eventually(checkpointOf).Should(Equal("101"))
+	})
+
+	It("should resume from the checkpoint when the API server closes the watch", func() {
+		start("100")
+		var w *watch.FakeWatcher
+		Eventually(versions).Should(Receive(Equal("100")))
+		Eventually(watchers).Should(Receive(&w))
+
+		w.Add(newEvent("a", "101"))
+		w.Stop()
+
+		Eventually(versions).Should(Receive(Equal("101")))
+		Expect(receivedLines()).To(HaveLen(1))
+	})
+
+	It("should start from the current resource version without a checkpoint", func() {
+		start("")
+		Eventually(versions).Should(Receive(Equal("500")))
+		Expect(checkpointOf()).To(Equal("500"))
+	})
+
+	It("should restart from the current resource version when the checkpoint expired", func() {
+		start("100")
+		var w *watch.FakeWatcher
+		Eventually(versions).Should(Receive(Equal("100")))
+		Eventually(watchers).Should(Receive(&w))
+
+		expired := apierrors.NewResourceExpired("too old resource version: 100 (400)")
+		w.Error(&expired.ErrStatus)
+
+		Eventually(versions).Should(Receive(Equal("500")))
+		Expect(checkpointOf()).To(Equal("500"))
+	})
+})
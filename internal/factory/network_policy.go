@@ -21,7 +21,7 @@ type PortProtocol struct {
 }
 
 // NewNetworkPolicyWithProtocolPorts creates a NetworkPolicy with protocol-aware port configuration.
-func NewNetworkPolicyWithProtocolPorts(namespace, policyName, instanceName, component, policyRuleSet string, egressPorts []PortProtocol, ingressPorts []int32, visitors ...func(o runtime.Object)) *networkingv1.NetworkPolicy {
+func NewNetworkPolicyWithProtocolPorts(namespace, policyName, instanceName, component, policyRuleSet string, egressPorts []PortProtocol, ingressPorts []PortProtocol, visitors ...func(o runtime.Object)) *networkingv1.NetworkPolicy {
 	// Create the base NetworkPolicy
 	np := runtime.NewNetworkPolicy(namespace, policyName, visitors...)
 
@@ -67,14 +67,14 @@ func NetworkPolicyTypeAllowIngressMetrics(npBuilder *runtime.NetworkPolicyBuilde
 
 // NetworkPolicyTypeRestrictIngressEgressWithProtocols configures the network policy to restrict ingress and egress traffic
 // It allows ingress on specified ports and egress to specified ports with their protocols.
-func NetworkPolicyTypeRestrictIngressEgressWithProtocols(npBuilder *runtime.NetworkPolicyBuilder, ingressPorts []int32, egressPorts []PortProtocol, metricsPort int32) *runtime.NetworkPolicyBuilder {
+func NetworkPolicyTypeRestrictIngressEgressWithProtocols(npBuilder *runtime.NetworkPolicyBuilder, ingressPorts []PortProtocol, egressPorts []PortProtocol, metricsPort int32) *runtime.NetworkPolicyBuilder {
 	// Ingress rules are allowed on the metrics port and all additional spec'd ingress ports
 	ingressRule := npBuilder.NewIngressRule().
 		OnPort(corev1.ProtocolTCP, metricsPort)
 
-	// Add all additional spec'd ingress ports to the same rule (still TCP for inputs)
-	for _, port := range ingressPorts {
-		ingressRule.OnPort(corev1.ProtocolTCP, port)
+	// Add all additional spec'd ingress ports to the same rule with their protocols
+	for _, portProtocol := range ingressPorts {
+		ingressRule.OnPort(portProtocol.Protocol, portProtocol.Port)
 	}
 	ingressRule.End()
 
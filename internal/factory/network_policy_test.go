@@ -112,7 +112,7 @@ var _ = Describe("#NewNetworkPolicy", func() {
 	})
 
 	DescribeTable("Ruleset configuration",
-		func(ruleSet string, ingressPorts []int32, egressPorts []PortProtocol, expectedPolicyTypes []networkingv1.PolicyType, expectedIngressRules []networkingv1.NetworkPolicyIngressRule, expectedEgressRules []networkingv1.NetworkPolicyEgressRule) {
+		func(ruleSet string, ingressPorts []PortProtocol, egressPorts []PortProtocol, expectedPolicyTypes []networkingv1.PolicyType, expectedIngressRules []networkingv1.NetworkPolicyIngressRule, expectedEgressRules []networkingv1.NetworkPolicyEgressRule) {
 			np := NewNetworkPolicyWithProtocolPorts(namespace, policyName, instanceName, constants.CollectorName, ruleSet, egressPorts, ingressPorts, commonLabels)
 
 			// Verify policy types are set correctly based on the ruleset
@@ -158,7 +158,7 @@ var _ = Describe("#NewNetworkPolicy", func() {
 		),
 		Entry("with RestrictIngressEgress ruleset",
 			string(obsv1.NetworkPolicyRuleSetTypeRestrictIngressEgress),
-			[]int32{5000},
+			[]PortProtocol{{Port: 5000, Protocol: corev1.ProtocolTCP}},
 			[]PortProtocol{{Port: 8080, Protocol: corev1.ProtocolTCP}, {Port: 5140, Protocol: corev1.ProtocolTCP}, {Port: 9200, Protocol: corev1.ProtocolTCP}},
 			[]networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
 			[]networkingv1.NetworkPolicyIngressRule{{
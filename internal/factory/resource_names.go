@@ -13,6 +13,9 @@ type ForwarderResourceNames struct {
 	ConfigMap                        string
 	MetadataReaderClusterRoleBinding string
 	MetricsAuthClusterRoleBinding    string
+	EventsReaderClusterRoleBinding   string
+	EventsWatcher                    string
+	EventsCheckpoint                 string
 	CaTrustBundle                    string
 	ServiceAccount                   string
 	InternalLogStoreSecret           string
@@ -40,6 +43,9 @@ func ResourceNames(clf obsv1.ClusterLogForwarder) *ForwarderResourceNames {
 		ConfigMap:                        resBaseName + "-config",
 		MetadataReaderClusterRoleBinding: fmt.Sprintf("cluster-logging-%s-%s-metadata-reader", clf.Namespace, resBaseName),
 		MetricsAuthClusterRoleBinding:    fmt.Sprintf("cluster-logging-%s-%s-metrics-auth", clf.Namespace, resBaseName),
+		EventsReaderClusterRoleBinding:   fmt.Sprintf("cluster-logging-%s-%s-events-reader", clf.Namespace, resBaseName),
+		EventsWatcher:                    resBaseName + "-events-watcher",
+		EventsCheckpoint:                 resBaseName + "-events-checkpoint",
 		ForwarderName:                    clf.Name,
 		CaTrustBundle:                    resBaseName + "-trustbundle",
 		ServiceAccount:                   clf.Spec.ServiceAccount.Name,
@@ -16,6 +16,10 @@ func (i *Input) InputIDs() []string {
 }
 
 func (i *Input) GetTlsSpec() *obs.TLSSpec {
+	if i.Kafka != nil && i.Kafka.TLS != nil {
+		tlsSpec := obs.TLSSpec(*i.Kafka.TLS)
+		return &tlsSpec
+	}
 	if i.Receiver == nil || i.Receiver.TLS == nil {
 		return nil
 	}
@@ -2,6 +2,7 @@ package adapters
 
 import (
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
 )
 
@@ -11,4 +12,12 @@ type InternalFilterSpec struct {
 
 	// Factory creates a new instance of a transform
 	Factory func(inputs ...string) types.Transform
+
+	// ChainFactory optionally replaces Factory for filters requiring a sequence of transforms.
+	// The last transform of the sequence must be identified by id
+	ChainFactory func(id string, inputs ...string) api.Transforms
+
+	// MetricsFactory optionally creates the transforms that convert the records leaving the filter to metrics.
+	// The transform identified by id publishes the metrics to the collector metrics endpoint
+	MetricsFactory func(id string, inputs ...string) api.Transforms
 }
@@ -20,6 +20,7 @@ type Pipeline struct {
 	index      int
 	filterMap  map[string]InternalFilterSpec
 	Filters    []*PipelineFilter
+	Route      *PipelineRoute
 	inputSpecs []obs.InputSpec
 }
 
@@ -27,15 +28,41 @@ type Pipeline struct {
 func (p *Pipeline) Transforms() (api.Transforms, error) {
 	tfs := api.Transforms{}
 	for _, pf := range p.Filters {
-		tf := pf.Transform()
-		if tf == nil {
-			return nil, fmt.Errorf("filter %q produced nil transform for pipeline %q", pf.ID(), p.Name())
+		if pf.ChainFactory != nil {
+			chain := pf.ChainTransforms()
+			if chain[pf.ID()] == nil {
+				return nil, fmt.Errorf("filter %q produced nil transform for pipeline %q", pf.ID(), p.Name())
+			}
+			tfs.Merge(chain)
+		} else {
+			tf := pf.Transform()
+			if tf == nil {
+				return nil, fmt.Errorf("filter %q produced nil transform for pipeline %q", pf.ID(), p.Name())
+			}
+			tfs.Add(pf.ID(), tf)
+		}
+		tfs.Merge(pf.MetricsTransforms())
+	}
+	if p.Route != nil {
+		tf, err := p.Route.Transform()
+		if err != nil {
+			return nil, fmt.Errorf("pipeline %q: %w", p.Name(), err)
 		}
-		tfs.Add(pf.ID(), tf)
+		tfs.Add(p.Route.ID(), tf)
 	}
 	return tfs, nil
 }
 
+// MetricsIDs returns the IDs of the transforms publishing the metrics of the pipeline filters
+func (p *Pipeline) MetricsIDs() (ids []string) {
+	for _, pf := range p.Filters {
+		if id := pf.MetricsID(); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
 func NewPipeline(index int, p obs.PipelineSpec, inputs map[string]helpers.InputComponent, outputs map[string]*Output, filters map[string]*InternalFilterSpec, inputSpecs []obs.InputSpec, addPostFilters func(p *Pipeline)) *Pipeline {
 	pipeline := &Pipeline{
 		PipelineSpec: p,
@@ -69,9 +96,19 @@ func NewPipeline(index int, p obs.PipelineSpec, inputs map[string]helpers.InputC
 			first.AddInputFrom(inputs[inputRefs])
 		}
 		last := pipeline.Filters[len(pipeline.FilterRefs)-1]
-		for _, name := range pipeline.OutputRefs {
-			outputs[name].AddInputFrom(last)
+		if len(pipeline.Routes) > 0 {
+			pipeline.addRoute(outputs, last)
+		} else {
+			for _, name := range pipeline.OutputRefs {
+				outputs[name].AddInputFrom(last)
+			}
 		}
+	} else if len(pipeline.Routes) > 0 {
+		var components []helpers.InputComponent
+		for _, inputRefs := range pipeline.InputRefs {
+			components = append(components, inputs[inputRefs])
+		}
+		pipeline.addRoute(outputs, components...)
 	} else {
 		for _, outputRef := range pipeline.OutputRefs {
 			if output, found := outputs[outputRef]; found {
@@ -85,6 +122,22 @@ func NewPipeline(index int, p obs.PipelineSpec, inputs map[string]helpers.InputC
 	return pipeline
 }
 
+// addRoute wires the given components to the outputs of the pipeline through the route of the pipeline
+func (p *Pipeline) addRoute(outputs map[string]*Output, components ...helpers.InputComponent) {
+	p.Route = NewPipelineRoute(p.Name(), p.Routes)
+	for _, c := range components {
+		p.Route.AddInputFrom(c)
+	}
+	for _, route := range p.Routes {
+		for _, name := range route.OutputRefs {
+			outputs[name].AddInputFrom(p.Route.Route(route.Name))
+		}
+	}
+	for _, name := range p.OutputRefs {
+		outputs[name].AddInputFrom(p.Route.Unmatched())
+	}
+}
+
 func AddSystemFilters(p *Pipeline) {
 	postFilterName := v1.Viaq
 	p.filterMap[postFilterName] = InternalFilterSpec{
@@ -99,7 +152,8 @@ func AddSystemFilters(p *Pipeline) {
 
 	for _, refName := range p.FilterRefs {
 		spec, exists := p.filterMap[refName]
-		isEarlyStage := exists && (spec.Type == obs.FilterTypeParse || spec.Type == obs.FilterTypeDetectMultiline)
+		// A parse filter with a spec parses fields of the normalized record so it keeps its position
+		isEarlyStage := exists && ((spec.Type == obs.FilterTypeParse && spec.ParseFilterSpec == nil) || spec.Type == obs.FilterTypeDetectMultiline)
 		if isEarlyStage {
 			preFilters = append(preFilters, refName)
 		} else {
@@ -3,6 +3,7 @@ package adapters
 import (
 	"sort"
 
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 )
@@ -12,6 +13,9 @@ type PipelineFilter struct {
 	ids     []string
 	Next    []helpers.InputComponent
 	Factory func(inputs ...string) types.Transform
+
+	ChainFactory   func(id string, inputs ...string) api.Transforms
+	MetricsFactory func(id string, inputs ...string) api.Transforms
 }
 
 func (pf *PipelineFilter) ID() string {
@@ -28,13 +32,32 @@ func (pf *PipelineFilter) AddInputFrom(n helpers.InputComponent) {
 func NewPipelineFilter(pipelineName, filterRef string, spec InternalFilterSpec) *PipelineFilter {
 	ids := []string{helpers.MakePipelineID(pipelineName, filterRef)}
 	return &PipelineFilter{
-		ids:     ids,
-		Factory: spec.Factory,
+		ids:            ids,
+		Factory:        spec.Factory,
+		ChainFactory:   spec.ChainFactory,
+		MetricsFactory: spec.MetricsFactory,
+	}
+}
+
+// MetricsID is the ID of the transform publishing the metrics of the filter or empty if the filter has no metrics
+func (pf *PipelineFilter) MetricsID() string {
+	if pf.MetricsFactory == nil {
+		return ""
 	}
+	return helpers.MakeID(pf.ID(), "metrics")
 }
 
 // Transform creates an instance of a transform based upon the instance of a filter referenced by a pipeline
 func (pf *PipelineFilter) Transform() types.Transform {
+	return pf.Factory(pf.inputs()...)
+}
+
+// ChainTransforms creates the sequence of transforms of a filter requiring more than one transform
+func (pf *PipelineFilter) ChainTransforms() api.Transforms {
+	return pf.ChainFactory(pf.ID(), pf.inputs()...)
+}
+
+func (pf *PipelineFilter) inputs() []string {
 	inputs := []string{}
 	for _, n := range pf.Next {
 		if n != nil {
@@ -42,5 +65,13 @@ func (pf *PipelineFilter) Transform() types.Transform {
 		}
 	}
 	sort.Strings(inputs)
-	return pf.Factory(inputs...)
+	return inputs
+}
+
+// MetricsTransforms creates the transforms converting the records leaving the filter to metrics
+func (pf *PipelineFilter) MetricsTransforms() api.Transforms {
+	if pf.MetricsFactory == nil {
+		return api.Transforms{}
+	}
+	return pf.MetricsFactory(pf.MetricsID(), pf.ID())
 }
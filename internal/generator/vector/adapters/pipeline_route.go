@@ -0,0 +1,73 @@
+package adapters
+
+import (
+	"fmt"
+	"sort"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/drop"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+// PipelineRoute sends the records leaving a pipeline to the outputs of the routes they match
+type PipelineRoute struct {
+	id     string
+	routes []obs.PipelineRoute
+	Next   []helpers.InputComponent
+}
+
+// routeComponent is the stream of records of a single route
+type routeComponent string
+
+func (c routeComponent) InputIDs() []string {
+	return []string{string(c)}
+}
+
+func NewPipelineRoute(pipelineName string, routes []obs.PipelineRoute) *PipelineRoute {
+	return &PipelineRoute{
+		id:     helpers.MakePipelineID(pipelineName, "route"),
+		routes: routes,
+	}
+}
+
+func (r *PipelineRoute) ID() string {
+	return r.id
+}
+
+func (r *PipelineRoute) AddInputFrom(n helpers.InputComponent) {
+	r.Next = append(r.Next, n)
+}
+
+// Route returns the component of the records matching the named route
+func (r *PipelineRoute) Route(name string) helpers.InputComponent {
+	return routeComponent(helpers.MakeRouteInputID(r.id, helpers.FormatComponentID(name)))
+}
+
+// Unmatched returns the component of the records matching none of the routes
+func (r *PipelineRoute) Unmatched() helpers.InputComponent {
+	return routeComponent(transforms.UnmatchedRoute(r.id))
+}
+
+// Transform creates the route transform evaluating the tests of each route
+func (r *PipelineRoute) Transform() (types.Transform, error) {
+	inputs := []string{}
+	for _, n := range r.Next {
+		if n != nil {
+			inputs = append(inputs, n.InputIDs()...)
+		}
+	}
+	sort.Strings(inputs)
+	routes := map[string]string{}
+	for _, route := range r.routes {
+		condition, err := drop.NewFilter(route.Tests).Condition()
+		if err != nil {
+			return nil, fmt.Errorf("route %q: %w", route.Name, err)
+		}
+		routes[helpers.FormatComponentID(route.Name)] = condition
+	}
+	return transforms.NewRoute(func(t *transforms.Route) {
+		t.Routes = routes
+	}, inputs...), nil
+}
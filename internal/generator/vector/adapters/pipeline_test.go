@@ -148,4 +148,143 @@ var _ = Describe("Pipeline adapters", func() {
 
 		})
 	})
+
+	Describe("#MetricsIDs", func() {
+		It("should add the metrics transforms of filters publishing metrics", func() {
+			metricsFilterMap := map[string]*adapters.InternalFilterSpec{
+				"metricsFilter": {
+					FilterSpec: &obs.FilterSpec{
+						Name: "metricsFilter",
+						Type: obs.FilterTypeLogToMetric,
+					},
+					Factory: func(inputs ...string) types.Transform {
+						return transforms.NewRemap("fakeElementVRL", inputs...)
+					},
+					MetricsFactory: func(id string, inputs ...string) api.Transforms {
+						return api.Transforms{id: transforms.NewRemap("fakeMetricsVRL", inputs...)}
+					},
+				},
+			}
+			adapter := adapters.NewPipeline(0, obs.PipelineSpec{
+				Name:       "mypipeline",
+				InputRefs:  []string{"app-in"},
+				FilterRefs: []string{"metricsFilter"},
+				OutputRefs: []string{"referenced"},
+			}, inputMap,
+				outputMap,
+				metricsFilterMap,
+				inputSpecs,
+				func(p *adapters.Pipeline) {},
+			)
+			Expect(adapter.MetricsIDs()).To(Equal([]string{"pipeline_mypipeline_metricsfilter_0_metrics"}))
+			tfs, err := adapter.Transforms()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(api.Transforms{
+				"pipeline_mypipeline_metricsfilter_0":         transforms.NewRemap("fakeElementVRL", "input_app_in_container_meta"),
+				"pipeline_mypipeline_metricsfilter_0_metrics": transforms.NewRemap("fakeMetricsVRL", "pipeline_mypipeline_metricsfilter_0"),
+			}).To(Equal(tfs))
+			Expect(outputMap["referenced"].Inputs()).To(Equal([]string{"pipeline_mypipeline_metricsfilter_0"}))
+		})
+	})
+
+	Describe("#Transforms with a chain factory", func() {
+		It("should add the sequence of transforms of filters requiring more than one transform", func() {
+			chainFilterMap := map[string]*adapters.InternalFilterSpec{
+				"chainFilter": {
+					FilterSpec: &obs.FilterSpec{
+						Name: "chainFilter",
+						Type: obs.FilterTypeDedupe,
+					},
+					ChainFactory: func(id string, inputs ...string) api.Transforms {
+						return api.Transforms{
+							id + "_first": transforms.NewRemap("fakeFirstVRL", inputs...),
+							id:            transforms.NewRemap("fakeLastVRL", id+"_first"),
+						}
+					},
+				},
+			}
+			adapter := adapters.NewPipeline(0, obs.PipelineSpec{
+				Name:       "mypipeline",
+				InputRefs:  []string{"app-in"},
+				FilterRefs: []string{"chainFilter"},
+				OutputRefs: []string{"referenced"},
+			}, inputMap,
+				outputMap,
+				chainFilterMap,
+				inputSpecs,
+				func(p *adapters.Pipeline) {},
+			)
+			tfs, err := adapter.Transforms()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(api.Transforms{
+				"pipeline_mypipeline_chainfilter_0_first": transforms.NewRemap("fakeFirstVRL", "input_app_in_container_meta"),
+				"pipeline_mypipeline_chainfilter_0":       transforms.NewRemap("fakeLastVRL", "pipeline_mypipeline_chainfilter_0_first"),
+			}).To(Equal(tfs))
+			Expect(outputMap["referenced"].Inputs()).To(Equal([]string{"pipeline_mypipeline_chainfilter_0"}))
+		})
+
+		It("should return an error when the sequence does not end with the transform of the filter", func() {
+			chainFilterMap := map[string]*adapters.InternalFilterSpec{
+				"chainFilter": {
+					FilterSpec: &obs.FilterSpec{
+						Name: "chainFilter",
+						Type: obs.FilterTypeDedupe,
+					},
+					ChainFactory: func(id string, inputs ...string) api.Transforms {
+						return api.Transforms{id + "_first": transforms.NewRemap("fakeFirstVRL", inputs...)}
+					},
+				},
+			}
+			adapter := adapters.NewPipeline(0, obs.PipelineSpec{
+				Name:       "mypipeline",
+				InputRefs:  []string{"app-in"},
+				FilterRefs: []string{"chainFilter"},
+				OutputRefs: []string{"referenced"},
+			}, inputMap,
+				outputMap,
+				chainFilterMap,
+				inputSpecs,
+				func(p *adapters.Pipeline) {},
+			)
+			_, err := adapter.Transforms()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#Routes", func() {
+		It("should route the records of the pipeline to the outputs of the matching routes", func() {
+			adapter := adapters.NewPipeline(0, obs.PipelineSpec{
+				Name:       "mypipeline",
+				InputRefs:  []string{"app-in"},
+				FilterRefs: []string{"dropFilter"},
+				OutputRefs: []string{"notReferenced"},
+				Routes: []obs.PipelineRoute{
+					{
+						Name: "my-errors",
+						Tests: []obs.DropTest{
+							{DropConditions: []obs.DropCondition{{Field: ".level", Matches: "error"}}},
+						},
+						OutputRefs: []string{"referenced"},
+					},
+				},
+			}, inputMap,
+				outputMap,
+				internalFilterMap,
+				inputSpecs,
+				func(p *adapters.Pipeline) {},
+			)
+			tfs, err := adapter.Transforms()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(api.Transforms{
+				"pipeline_mypipeline_dropfilter_0": transforms.NewRemap("fakeElementVRL", "input_app_in_container_meta"),
+				"pipeline_mypipeline_route": transforms.NewRoute(func(r *transforms.Route) {
+					r.Routes = map[string]string{
+						"my_errors": `(match(to_string(._internal.level) ?? "", r'error'))`,
+					}
+				}, "pipeline_mypipeline_dropfilter_0"),
+			}).To(Equal(tfs))
+			Expect(outputMap["referenced"].Inputs()).To(Equal([]string{"pipeline_mypipeline_route.my_errors"}))
+			Expect(outputMap["notReferenced"].Inputs()).To(Equal([]string{"pipeline_mypipeline_route._unmatched"}))
+		})
+	})
 })
@@ -13,6 +13,9 @@ type Config struct {
 	// Secrets is the set of secret ids to secret configurations
 	Secret map[string]*Secret `json:"secret,omitempty" yaml:"secret,omitempty" toml:"secret,omitempty"`
 
+	// EnrichmentTables is the set of enrichment table ids to enrichment table configurations
+	EnrichmentTables map[string]*EnrichmentTable `json:"enrichment_tables,omitempty" yaml:"enrichment_tables,omitempty" toml:"enrichment_tables,omitempty"`
+
 	// Sources is the set of source ids to source configurations
 	Sources Sources `json:"sources,omitempty" yaml:"sources,omitempty" toml:"sources,omitempty"`
 
@@ -41,6 +44,15 @@ func (c *Config) AddSinks(sinks Sinks) {
 	}
 }
 
+func (c *Config) AddEnrichmentTables(tables map[string]*EnrichmentTable) {
+	if len(tables) > 0 && c.EnrichmentTables == nil {
+		c.EnrichmentTables = make(map[string]*EnrichmentTable)
+	}
+	for id, t := range tables {
+		c.EnrichmentTables[id] = t
+	}
+}
+
 func (c *Config) AddSources(sources Sources) {
 	for id, s := range sources {
 		c.Sources[id] = s
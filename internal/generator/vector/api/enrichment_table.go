@@ -0,0 +1,38 @@
+package api
+
+type EnrichmentTableType string
+
+const (
+	EnrichmentTableTypeFile EnrichmentTableType = "file"
+
+	EnrichmentTableEncodingCSV = "csv"
+)
+
+type EnrichmentTable struct {
+	Type EnrichmentTableType  `json:"type" yaml:"type" toml:"type"`
+	File *EnrichmentTableFile `json:"file,omitempty" yaml:"file,omitempty" toml:"file,omitempty"`
+}
+
+type EnrichmentTableFile struct {
+	Path     string                   `json:"path" yaml:"path" toml:"path"`
+	Encoding *EnrichmentTableEncoding `json:"encoding,omitempty" yaml:"encoding,omitempty" toml:"encoding,omitempty"`
+}
+
+type EnrichmentTableEncoding struct {
+	Type           string `json:"type" yaml:"type" toml:"type"`
+	IncludeHeaders bool   `json:"include_headers" yaml:"include_headers" toml:"include_headers"`
+}
+
+// NewCSVFileTable returns an enrichment table loaded from a CSV file with a header row
+func NewCSVFileTable(path string) *EnrichmentTable {
+	return &EnrichmentTable{
+		Type: EnrichmentTableTypeFile,
+		File: &EnrichmentTableFile{
+			Path: path,
+			Encoding: &EnrichmentTableEncoding{
+				Type:           EnrichmentTableEncodingCSV,
+				IncludeHeaders: true,
+			},
+		},
+	}
+}
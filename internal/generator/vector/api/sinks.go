@@ -57,6 +57,12 @@ func (sinkMap *Sinks) UnmarshalTOML(data interface{}) (err error) {
 				return fmt.Errorf("failed to unmarshal sink %s: %w", id, err)
 			}
 			sink = &s
+		case types.SinkTypeAzureBlob:
+			var s sinks.AzureBlob
+			if err = tree.Unmarshal(&s); err != nil {
+				return fmt.Errorf("failed to unmarshal sink %s: %w", id, err)
+			}
+			sink = &s
 		case types.SinkTypeAzureLogsIngestion:
 			var s sinks.AzureLogsIngestion
 			if err = tree.Unmarshal(&s); err != nil {
@@ -69,12 +75,24 @@ func (sinkMap *Sinks) UnmarshalTOML(data interface{}) (err error) {
 				return fmt.Errorf("failed to unmarshal sink %s: %w", id, err)
 			}
 			sink = &s
+		case types.SinkTypeBlackhole:
+			var s sinks.Blackhole
+			if err = tree.Unmarshal(&s); err != nil {
+				return fmt.Errorf("failed to unmarshal sink %s: %w", id, err)
+			}
+			sink = &s
 		case types.SinkTypeElasticsearch:
 			var s sinks.Elasticsearch
 			if err = tree.Unmarshal(&s); err != nil {
 				return fmt.Errorf("failed to unmarshal sink %s: %w", id, err)
 			}
 			sink = &s
+		case types.SinkTypeGcpCloudStorage:
+			var s sinks.GcpCloudStorage
+			if err = tree.Unmarshal(&s); err != nil {
+				return fmt.Errorf("failed to unmarshal sink %s: %w", id, err)
+			}
+			sink = &s
 		case types.SinkTypeGcpStackdriverLogs:
 			var s sinks.GcpStackdriverLogs
 			if err = tree.Unmarshal(&s); err != nil {
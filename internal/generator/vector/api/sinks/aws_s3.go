@@ -14,8 +14,13 @@ type AwsS3 struct {
 	KeyPrefix string         `json:"key_prefix,omitempty" yaml:"key_prefix,omitempty" toml:"key_prefix,omitempty"`
 	Endpoint  string         `json:"endpoint,omitempty" yaml:"endpoint,omitempty" toml:"endpoint,omitempty"`
 
+	FilenameExtension string `json:"filename_extension,omitempty" yaml:"filename_extension,omitempty" toml:"filename_extension,omitempty"`
+	ContentType       string `json:"content_type,omitempty" yaml:"content_type,omitempty" toml:"content_type,omitempty"`
+
 	BaseSink
 
+	Framing *Framing `json:"framing,omitempty" yaml:"framing,omitempty" toml:"framing,omitempty"`
+
 	Auth *AwsAuth `json:"auth,omitempty" yaml:"auth,omitempty" toml:"auth,omitempty"`
 
 	HealthCheck HealthCheck `json:"healthcheck" yaml:"healthcheck" toml:"healthcheck"`
@@ -0,0 +1,40 @@
+package sinks
+
+import (
+	"sort"
+
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+)
+
+type AzureBlob struct {
+	Type           types.SinkType `json:"type,omitempty" yaml:"type,omitempty" toml:"type,omitempty"`
+	Inputs         []string       `json:"inputs,omitempty" yaml:"inputs,omitempty" toml:"inputs,omitempty"`
+	StorageAccount string         `json:"storage_account,omitempty" yaml:"storage_account,omitempty" toml:"storage_account,omitempty"`
+	ContainerName  string         `json:"container_name,omitempty" yaml:"container_name,omitempty" toml:"container_name,omitempty"`
+	BlobPrefix     string         `json:"blob_prefix,omitempty" yaml:"blob_prefix,omitempty" toml:"blob_prefix,omitempty"`
+	Endpoint       string         `json:"endpoint,omitempty" yaml:"endpoint,omitempty" toml:"endpoint,omitempty"`
+
+	BaseSink
+
+	Framing *Framing `json:"framing,omitempty" yaml:"framing,omitempty" toml:"framing,omitempty"`
+
+	Auth *AzureLogsIngestionAuth `json:"auth,omitempty" yaml:"auth,omitempty" toml:"auth,omitempty"`
+
+	HealthCheck HealthCheck `json:"healthcheck" yaml:"healthcheck" toml:"healthcheck"`
+}
+
+func NewAzureBlob(init func(s *AzureBlob), inputs ...string) (s *AzureBlob) {
+	sort.Strings(inputs)
+	s = &AzureBlob{
+		Type:   types.SinkTypeAzureBlob,
+		Inputs: inputs,
+	}
+	if init != nil {
+		init(s)
+	}
+	return s
+}
+
+func (s *AzureBlob) SinkType() types.SinkType {
+	return s.Type
+}
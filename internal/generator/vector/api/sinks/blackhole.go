@@ -0,0 +1,25 @@
+package sinks
+
+import (
+	"sort"
+
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+)
+
+// Blackhole discards the events it receives
+type Blackhole struct {
+	Type   types.SinkType `json:"type" yaml:"type" toml:"type"`
+	Inputs []string       `json:"inputs" yaml:"inputs" toml:"inputs"`
+}
+
+func (b Blackhole) SinkType() types.SinkType {
+	return b.Type
+}
+
+func NewBlackhole(inputs ...string) *Blackhole {
+	sort.Strings(inputs)
+	return &Blackhole{
+		Type:   types.SinkTypeBlackhole,
+		Inputs: inputs,
+	}
+}
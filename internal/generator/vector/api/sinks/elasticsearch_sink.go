@@ -12,10 +12,13 @@ type Elasticsearch struct {
 	Endpoints  []string                `json:"endpoints,omitempty" yaml:"endpoints,omitempty" toml:"endpoints,omitempty"`
 	IdKey      string                  `json:"id_key,omitempty" yaml:"id_key,omitempty" toml:"id_key,omitempty"`
 	ApiVersion ElasticsearchApiVersion `json:"api_version,omitempty" yaml:"api_version,omitempty" toml:"api_version,omitempty"`
+	Mode       ElasticsearchMode       `json:"mode,omitempty" yaml:"mode,omitempty" toml:"mode,omitempty"`
+	Pipeline   string                  `json:"pipeline,omitempty" yaml:"pipeline,omitempty" toml:"pipeline,omitempty"`
 	BaseSink
-	Bulk  *Bulk              `json:"bulk,omitempty" yaml:"bulk,omitempty" toml:"bulk,omitempty"`
-	Auth  *ElasticsearchAuth `json:"auth,omitempty" yaml:"auth,omitempty" toml:"auth,omitempty"`
-	Proxy *Proxy             `json:"proxy,omitempty" yaml:"proxy,omitempty" toml:"proxy,omitempty"`
+	Bulk       *Bulk              `json:"bulk,omitempty" yaml:"bulk,omitempty" toml:"bulk,omitempty"`
+	DataStream *DataStream        `json:"data_stream,omitempty" yaml:"data_stream,omitempty" toml:"data_stream,omitempty"`
+	Auth       *ElasticsearchAuth `json:"auth,omitempty" yaml:"auth,omitempty" toml:"auth,omitempty"`
+	Proxy      *Proxy             `json:"proxy,omitempty" yaml:"proxy,omitempty" toml:"proxy,omitempty"`
 }
 
 func NewElasticsearch(url string, init func(s *Elasticsearch), inputs ...string) (s *Elasticsearch) {
@@ -65,8 +68,21 @@ type BulkActionType string
 
 const (
 	BulkActionCreate BulkActionType = "create"
+	BulkActionIndex  BulkActionType = "index"
 )
 
+type ElasticsearchMode string
+
+const (
+	ElasticsearchModeDataStream ElasticsearchMode = "data_stream"
+)
+
+type DataStream struct {
+	Type      string `json:"type,omitempty" yaml:"type,omitempty" toml:"type,omitempty"`
+	Dataset   string `json:"dataset,omitempty" yaml:"dataset,omitempty" toml:"dataset,omitempty"`
+	Namespace string `json:"namespace,omitempty" yaml:"namespace,omitempty" toml:"namespace,omitempty"`
+}
+
 type Bulk struct {
 	Index  string         `json:"index,omitempty" yaml:"index,omitempty" toml:"index,omitempty"`
 	Action BulkActionType `json:"action,omitempty" yaml:"action,omitempty" toml:"action,omitempty"`
@@ -0,0 +1,40 @@
+package sinks
+
+import (
+	"sort"
+
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+)
+
+type GcpCloudStorage struct {
+	Type              types.SinkType `json:"type,omitempty" yaml:"type,omitempty" toml:"type,omitempty"`
+	Inputs            []string       `json:"inputs,omitempty" yaml:"inputs,omitempty" toml:"inputs,omitempty"`
+	Bucket            string         `json:"bucket,omitempty" yaml:"bucket,omitempty" toml:"bucket,omitempty"`
+	KeyPrefix         string         `json:"key_prefix,omitempty" yaml:"key_prefix,omitempty" toml:"key_prefix,omitempty"`
+	Endpoint          string         `json:"endpoint,omitempty" yaml:"endpoint,omitempty" toml:"endpoint,omitempty"`
+	CredentialsPath   string         `json:"credentials_path,omitempty" yaml:"credentials_path,omitempty" toml:"credentials_path,omitempty"`
+	FilenameExtension string         `json:"filename_extension,omitempty" yaml:"filename_extension,omitempty" toml:"filename_extension,omitempty"`
+	ContentType       string         `json:"content_type,omitempty" yaml:"content_type,omitempty" toml:"content_type,omitempty"`
+
+	BaseSink
+
+	Framing *Framing `json:"framing,omitempty" yaml:"framing,omitempty" toml:"framing,omitempty"`
+
+	HealthCheck HealthCheck `json:"healthcheck" yaml:"healthcheck" toml:"healthcheck"`
+}
+
+func NewGcpCloudStorage(init func(s *GcpCloudStorage), inputs ...string) (s *GcpCloudStorage) {
+	sort.Strings(inputs)
+	s = &GcpCloudStorage{
+		Type:   types.SinkTypeGcpCloudStorage,
+		Inputs: inputs,
+	}
+	if init != nil {
+		init(s)
+	}
+	return s
+}
+
+func (s *GcpCloudStorage) SinkType() types.SinkType {
+	return s.Type
+}
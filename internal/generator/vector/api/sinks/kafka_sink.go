@@ -12,6 +12,8 @@ type Kafka struct {
 	Inputs             []string              `json:"inputs,omitempty" yaml:"inputs,omitempty" toml:"inputs,omitempty"`
 	BootstrapServers   string                `json:"bootstrap_servers,omitempty" yaml:"bootstrap_servers,omitempty" toml:"bootstrap_servers,omitempty"`
 	Topic              string                `json:"topic,omitempty" yaml:"topic,omitempty" toml:"topic,omitempty"`
+	KeyField           string                `json:"key_field,omitempty" yaml:"key_field,omitempty" toml:"key_field,omitempty"`
+	HeadersKey         string                `json:"headers_key,omitempty" yaml:"headers_key,omitempty" toml:"headers_key,omitempty"`
 	Compression        CompressionType       `json:"compression,omitempty" yaml:"compression,omitempty" toml:"compression,omitempty"`
 	HealthCheck        *HealthCheck          `json:"healthcheck,omitempty" yaml:"healthcheck,omitempty" toml:"healthcheck,omitempty"`
 	Encoding           *Encoding             `json:"encoding,omitempty" yaml:"encoding,omitempty" toml:"encoding,omitempty"`
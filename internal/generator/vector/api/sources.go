@@ -41,6 +41,12 @@ func (sourceMap *Sources) UnmarshalTOML(data interface{}) (err error) {
 			return errors.Join(fmt.Errorf("unable to unmarshal source %q from %v to determine type", id, rawSource), err)
 		}
 		switch typeExtractor.Type {
+		case types.SourceTypeDemoLogs:
+			var s sources.DemoLogs
+			if err = tree.Unmarshal(&s); err != nil {
+				return fmt.Errorf("failed to unmarshal demo_logs source %s: %w", id, err)
+			}
+			source = &s
 		case types.SourceTypeFile:
 			var s sources.File
 			if err = tree.Unmarshal(&s); err != nil {
@@ -53,6 +59,12 @@ func (sourceMap *Sources) UnmarshalTOML(data interface{}) (err error) {
 				return fmt.Errorf("failed to unmarshal http_server source %s: %w", id, err)
 			}
 			source = &s
+		case types.SourceTypeInternalLogs:
+			var s sources.InternalLogs
+			if err = tree.Unmarshal(&s); err != nil {
+				return fmt.Errorf("failed to unmarshal internal_logs source %s: %w", id, err)
+			}
+			source = &s
 		case types.SourceTypeInternalMetrics:
 			var s sources.InternalMetrics
 			if err = tree.Unmarshal(&s); err != nil {
@@ -71,6 +83,18 @@ func (sourceMap *Sources) UnmarshalTOML(data interface{}) (err error) {
 				return fmt.Errorf("failed to unmarshal journald source %s: %w", id, err)
 			}
 			source = &s
+		case types.SourceTypeKafka:
+			var s sources.Kafka
+			if err = tree.Unmarshal(&s); err != nil {
+				return fmt.Errorf("failed to unmarshal kafka source %s: %w", id, err)
+			}
+			source = &s
+		case types.SourceTypeOpenTelemetry:
+			var s sources.OpenTelemetry
+			if err = tree.Unmarshal(&s); err != nil {
+				return fmt.Errorf("failed to unmarshal opentelemetry source %s: %w", id, err)
+			}
+			source = &s
 		case types.SourceTypeSyslog:
 			var s sources.Syslog
 			if err = tree.Unmarshal(&s); err != nil {
@@ -0,0 +1,28 @@
+package sources
+
+import (
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+)
+
+type DemoLogsFormat string
+
+const (
+	DemoLogsFormatJson DemoLogsFormat = "json"
+)
+
+// DemoLogs generates fake log events
+type DemoLogs struct {
+	Type   types.SourceType `json:"type" yaml:"type" toml:"type"`
+	Format DemoLogsFormat   `json:"format" yaml:"format" toml:"format"`
+}
+
+func (d DemoLogs) SourceType() types.SourceType {
+	return d.Type
+}
+
+func NewDemoLogs(format DemoLogsFormat) *DemoLogs {
+	return &DemoLogs{
+		Type:   types.SourceTypeDemoLogs,
+		Format: format,
+	}
+}
@@ -17,6 +17,26 @@ type File struct {
 	MaxLineBytes                      int64  `json:"max_line_bytes,omitempty" yaml:"max_line_bytes,omitempty" toml:"max_line_bytes,omitempty"`
 	MaxReadBytes                      int64  `json:"max_read_bytes,omitempty" yaml:"max_read_bytes,omitempty" toml:"max_read_bytes,omitempty"`
 	RotateWaitSecs                    int64  `json:"rotate_wait_secs,omitempty" yaml:"rotate_wait_secs,omitempty" toml:"rotate_wait_secs,omitempty"`
+
+	Multiline *FileMultiline `json:"multiline,omitempty" yaml:"multiline,omitempty" toml:"multiline,omitempty"`
+}
+
+// FileMultilineMode is the aggregation mode of multiline rules
+type FileMultilineMode string
+
+const (
+	FileMultilineModeContinueThrough FileMultilineMode = "continue_through"
+	FileMultilineModeContinuePast    FileMultilineMode = "continue_past"
+	FileMultilineModeHaltBefore      FileMultilineMode = "halt_before"
+	FileMultilineModeHaltWith        FileMultilineMode = "halt_with"
+)
+
+// FileMultiline aggregates consecutive lines of a file into a single event
+type FileMultiline struct {
+	StartPattern     string            `json:"start_pattern" yaml:"start_pattern" toml:"start_pattern"`
+	ConditionPattern string            `json:"condition_pattern" yaml:"condition_pattern" toml:"condition_pattern"`
+	Mode             FileMultilineMode `json:"mode" yaml:"mode" toml:"mode"`
+	TimeoutMs        int64             `json:"timeout_ms" yaml:"timeout_ms" toml:"timeout_ms"`
 }
 
 func (s File) SourceType() types.SourceType {
@@ -9,9 +9,14 @@ import (
 )
 
 type HttpServer struct {
-	Type     types.SourceType `json:"type" yaml:"type" toml:"type"`
-	Address  string           `json:"address" yaml:"address" toml:"address"`
-	Decoding *Decoding        `json:"decoding,omitempty" yaml:"decoding,omitempty" toml:"decoding,omitempty"`
+	Type    types.SourceType `json:"type" yaml:"type" toml:"type"`
+	Address string           `json:"address" yaml:"address" toml:"address"`
+
+	// Headers is the list of request headers added to each event
+	Headers []string `json:"headers,omitempty" yaml:"headers,omitempty" toml:"headers,omitempty"`
+
+	Framing  *Framing  `json:"framing,omitempty" yaml:"framing,omitempty" toml:"framing,omitempty"`
+	Decoding *Decoding `json:"decoding,omitempty" yaml:"decoding,omitempty" toml:"decoding,omitempty"`
 
 	TLS *transport.TlsEnabled `json:"tls,omitempty" yaml:"tls,omitempty" toml:"tls,omitempty"`
 }
@@ -30,3 +35,13 @@ func NewHttpServer(listenAddress string, listenPort int32) *HttpServer {
 type Decoding struct {
 	Codec codec.CodecType `json:"codec,omitempty" yaml:"codec,omitempty" toml:"codec,omitempty"`
 }
+
+type FramingMethod string
+
+const (
+	FramingMethodNewlineDelimited FramingMethod = "newline_delimited"
+)
+
+type Framing struct {
+	Method FramingMethod `json:"method,omitempty" yaml:"method,omitempty" toml:"method,omitempty"`
+}
@@ -0,0 +1,20 @@
+package sources
+
+import (
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+)
+
+// InternalLogs exposes the logs of the collector as events
+type InternalLogs struct {
+	Type types.SourceType `json:"type" yaml:"type" toml:"type"`
+}
+
+func (i *InternalLogs) SourceType() types.SourceType {
+	return types.SourceTypeInternalLogs
+}
+
+func NewInternalLogs() types.Source {
+	return &InternalLogs{
+		Type: types.SourceTypeInternalLogs,
+	}
+}
@@ -9,6 +9,18 @@ type Journald struct {
 	Type types.SourceType `json:"type" yaml:"type" toml:"type"`
 
 	JournalDirectory string `json:"journal_directory" yaml:"journal_directory" toml:"journal_directory"`
+
+	// CurrentBootOnly only reads the entries of the current boot
+	CurrentBootOnly *bool `json:"current_boot_only,omitempty" yaml:"current_boot_only,omitempty" toml:"current_boot_only,omitempty"`
+
+	// IncludeUnits is the list of units to read. Units without a '.' are suffixed with '.service'
+	IncludeUnits []string `json:"include_units,omitempty" yaml:"include_units,omitempty" toml:"include_units,omitempty"`
+
+	// ExcludeUnits is the list of units not to read. Units without a '.' are suffixed with '.service'
+	ExcludeUnits []string `json:"exclude_units,omitempty" yaml:"exclude_units,omitempty" toml:"exclude_units,omitempty"`
+
+	// ExcludeMatches drops the entries with any of the values of a field
+	ExcludeMatches map[string][]string `json:"exclude_matches,omitempty" yaml:"exclude_matches,omitempty" toml:"exclude_matches,omitempty"`
 }
 
 func NewJournalD() Journald {
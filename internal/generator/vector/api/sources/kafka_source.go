@@ -0,0 +1,39 @@
+package sources
+
+import (
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/transport"
+)
+
+// Kafka consumes records from the topics of a Kafka cluster as a member of a consumer group
+type Kafka struct {
+	Type             types.SourceType `json:"type" yaml:"type" toml:"type"`
+	BootstrapServers string           `json:"bootstrap_servers" yaml:"bootstrap_servers" toml:"bootstrap_servers"`
+	GroupId          string           `json:"group_id" yaml:"group_id" toml:"group_id"`
+	Topics           []string         `json:"topics" yaml:"topics" toml:"topics"`
+
+	Sasl *KafkaSasl            `json:"sasl,omitempty" yaml:"sasl,omitempty" toml:"sasl,omitempty"`
+	TLS  *transport.TlsEnabled `json:"tls,omitempty" yaml:"tls,omitempty" toml:"tls,omitempty"`
+
+	LibrdKafka_Options map[string]string `json:"librdkafka_options,omitempty" yaml:"librdkafka_options,omitempty" toml:"librdkafka_options,omitempty"`
+}
+
+type KafkaSasl struct {
+	Enabled   bool   `json:"enabled,omitempty" yaml:"enabled,omitempty" toml:"enabled,omitempty"`
+	Username  string `json:"username,omitempty" yaml:"username,omitempty" toml:"username,omitempty"`
+	Password  string `json:"password,omitempty" yaml:"password,omitempty" toml:"password,omitempty"`
+	Mechanism string `json:"mechanism,omitempty" yaml:"mechanism,omitempty" toml:"mechanism,omitempty"`
+}
+
+func (k Kafka) SourceType() types.SourceType {
+	return k.Type
+}
+
+func NewKafka(bootstrapServers, groupId string, topics ...string) *Kafka {
+	return &Kafka{
+		Type:             types.SourceTypeKafka,
+		BootstrapServers: bootstrapServers,
+		GroupId:          groupId,
+		Topics:           topics,
+	}
+}
@@ -7,18 +7,19 @@ import (
 type KubernetesLogs struct {
 
 	// Type is required to be 'kubernetes_logs'
-	Type                      types.SourceType           `json:"type" yaml:"type" toml:"type"`
-	MaxReadBytes              uint                       `json:"max_read_bytes,omitempty" yaml:"max_read_bytes,omitempty" toml:"max_read_bytes,omitempty"`
-	GlobMinimumCooldownMillis uint                       `json:"glob_minimum_cooldown_ms,omitempty" yaml:"glob_minimum_cooldown_ms,omitempty" toml:"glob_minimum_cooldown_ms,omitempty"`
-	AutoPartialMerge          bool                       `json:"auto_partial_merge,omitempty" yaml:"auto_partial_merge,omitempty" toml:"auto_partial_merge,omitempty"`
-	MaxMergedLineBytes        uint64                     `json:"max_merged_line_bytes,omitempty" yaml:"max_merged_line_bytes,omitempty" toml:"max_merged_line_bytes,omitempty"`
-	IncludePathsGlobPatterns  []string                   `json:"include_paths_glob_patterns,omitempty" yaml:"include_paths_glob_patterns,omitempty" toml:"include_paths_glob_patterns,omitempty"`
-	ExcludePathsGlobPatterns  []string                   `json:"exclude_paths_glob_patterns,omitempty" yaml:"exclude_paths_glob_patterns,omitempty" toml:"exclude_paths_glob_patterns,omitempty"`
-	ExtraLabelSelector        string                     `json:"extra_label_selector,omitempty" yaml:"extra_label_selector,omitempty" toml:"extra_label_selector,omitempty"`
-	RotateWaitSecs            uint                       `json:"rotate_wait_secs,omitempty" yaml:"rotate_wait_secs,omitempty" toml:"rotate_wait_secs,omitempty"`
-	UseApiServerCache         bool                       `json:"use_apiserver_cache,omitempty" yaml:"use_apiserver_cache,omitempty" toml:"use_apiserver_cache,omitempty"`
-	PodAnnotationFields       *PodAnnotationFields       `json:"pod_annotation_fields,omitempty" yaml:"pod_annotation_fields,omitempty" toml:"pod_annotation_fields,omitempty"`
-	NamespaceAnnotationFields *NamespaceAnnotationFields `json:"namespace_annotation_fields,omitempty" yaml:"namespace_annotation_fields,omitempty" toml:"namespace_annotation_fields,omitempty"`
+	Type                        types.SourceType           `json:"type" yaml:"type" toml:"type"`
+	MaxReadBytes                uint                       `json:"max_read_bytes,omitempty" yaml:"max_read_bytes,omitempty" toml:"max_read_bytes,omitempty"`
+	GlobMinimumCooldownMillis   uint                       `json:"glob_minimum_cooldown_ms,omitempty" yaml:"glob_minimum_cooldown_ms,omitempty" toml:"glob_minimum_cooldown_ms,omitempty"`
+	AutoPartialMerge            bool                       `json:"auto_partial_merge,omitempty" yaml:"auto_partial_merge,omitempty" toml:"auto_partial_merge,omitempty"`
+	MaxMergedLineBytes          uint64                     `json:"max_merged_line_bytes,omitempty" yaml:"max_merged_line_bytes,omitempty" toml:"max_merged_line_bytes,omitempty"`
+	IncludePathsGlobPatterns    []string                   `json:"include_paths_glob_patterns,omitempty" yaml:"include_paths_glob_patterns,omitempty" toml:"include_paths_glob_patterns,omitempty"`
+	ExcludePathsGlobPatterns    []string                   `json:"exclude_paths_glob_patterns,omitempty" yaml:"exclude_paths_glob_patterns,omitempty" toml:"exclude_paths_glob_patterns,omitempty"`
+	ExtraLabelSelector          string                     `json:"extra_label_selector,omitempty" yaml:"extra_label_selector,omitempty" toml:"extra_label_selector,omitempty"`
+	ExtraNamespaceLabelSelector string                     `json:"extra_namespace_label_selector,omitempty" yaml:"extra_namespace_label_selector,omitempty" toml:"extra_namespace_label_selector,omitempty"`
+	RotateWaitSecs              uint                       `json:"rotate_wait_secs,omitempty" yaml:"rotate_wait_secs,omitempty" toml:"rotate_wait_secs,omitempty"`
+	UseApiServerCache           bool                       `json:"use_apiserver_cache,omitempty" yaml:"use_apiserver_cache,omitempty" toml:"use_apiserver_cache,omitempty"`
+	PodAnnotationFields         *PodAnnotationFields       `json:"pod_annotation_fields,omitempty" yaml:"pod_annotation_fields,omitempty" toml:"pod_annotation_fields,omitempty"`
+	NamespaceAnnotationFields   *NamespaceAnnotationFields `json:"namespace_annotation_fields,omitempty" yaml:"namespace_annotation_fields,omitempty" toml:"namespace_annotation_fields,omitempty"`
 }
 
 func NewKubernetesLogs(init func(logs *KubernetesLogs)) *KubernetesLogs {
@@ -41,6 +42,7 @@ type PodAnnotationFields struct {
 	PodAnnotations string `json:"pod_annotations,omitempty" yaml:"pod_annotations,omitempty" toml:"pod_annotations,omitempty"`
 	PodUid         string `json:"pod_uid,omitempty" yaml:"pod_uid,omitempty" toml:"pod_uid,omitempty"`
 	PodNodeName    string `json:"pod_node_name,omitempty" yaml:"pod_node_name,omitempty" toml:"pod_node_name,omitempty"`
+	PodOwner       string `json:"pod_owner,omitempty" yaml:"pod_owner,omitempty" toml:"pod_owner,omitempty"`
 }
 
 type NamespaceAnnotationFields struct {
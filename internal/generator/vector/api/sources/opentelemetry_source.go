@@ -0,0 +1,32 @@
+package sources
+
+import (
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/transport"
+)
+
+// OpenTelemetryLogsOutput is the named output of the opentelemetry source emitting log events
+const OpenTelemetryLogsOutput = "logs"
+
+type OpenTelemetry struct {
+	Type types.SourceType       `json:"type" yaml:"type" toml:"type"`
+	GRPC *OpenTelemetryListener `json:"grpc" yaml:"grpc" toml:"grpc"`
+	HTTP *OpenTelemetryListener `json:"http" yaml:"http" toml:"http"`
+}
+
+type OpenTelemetryListener struct {
+	Address string                `json:"address" yaml:"address" toml:"address"`
+	TLS     *transport.TlsEnabled `json:"tls,omitempty" yaml:"tls,omitempty" toml:"tls,omitempty"`
+}
+
+func (o OpenTelemetry) SourceType() types.SourceType {
+	return o.Type
+}
+
+func NewOpenTelemetry(grpc, http *OpenTelemetryListener) *OpenTelemetry {
+	return &OpenTelemetry{
+		Type: types.SourceTypeOpenTelemetry,
+		GRPC: grpc,
+		HTTP: http,
+	}
+}
@@ -31,4 +31,5 @@ type SyslogMode string
 
 const (
 	SyslogModeTcp SyslogMode = "tcp"
+	SyslogModeUdp SyslogMode = "udp"
 )
@@ -45,6 +45,12 @@ func (tMap *Transforms) UnmarshalTOML(data interface{}) (err error) {
 			return errors.Join(fmt.Errorf("unable to unmarshal transform %q from %v to determine type", id, raw), err)
 		}
 		switch typeExtractor.Type {
+		case types.TransformTypeDedupe:
+			var t transforms.Dedupe
+			if err = tree.Unmarshal(&t); err != nil {
+				return fmt.Errorf("failed to unmarshal transform %q: %w", id, err)
+			}
+			transform = &t
 		case types.TransformTypeDetectExceptions:
 			var t transforms.DetectExceptions
 			if err = tree.Unmarshal(&t); err != nil {
@@ -81,6 +87,18 @@ func (tMap *Transforms) UnmarshalTOML(data interface{}) (err error) {
 				return fmt.Errorf("failed to unmarshal transform %q: %w", id, err)
 			}
 			transform = &s
+		case types.TransformTypeSample:
+			var s transforms.Sample
+			if err = tree.Unmarshal(&s); err != nil {
+				return fmt.Errorf("failed to unmarshal transform %q: %w", id, err)
+			}
+			transform = &s
+		case types.TransformTypeTagCardinalityLimit:
+			var s transforms.TagCardinalityLimit
+			if err = tree.Unmarshal(&s); err != nil {
+				return fmt.Errorf("failed to unmarshal transform %q: %w", id, err)
+			}
+			transform = &s
 		case types.TransformTypeThrottle:
 			var s transforms.Throttle
 			if err = tree.Unmarshal(&s); err != nil {
@@ -0,0 +1,44 @@
+package transforms
+
+import (
+	"sort"
+
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+)
+
+// Dedupe drops events which match one of a cache of recently seen events
+type Dedupe struct {
+	Type types.TransformType `json:"type" yaml:"type" toml:"type"`
+
+	// Inputs is the IDs of the components feeding into this component
+	Inputs []string `json:"inputs" yaml:"inputs" toml:"inputs"`
+
+	Fields *DedupeFields `json:"fields,omitempty" yaml:"fields,omitempty" toml:"fields,omitempty"`
+	Cache  *DedupeCache  `json:"cache,omitempty" yaml:"cache,omitempty" toml:"cache,omitempty"`
+}
+
+type DedupeFields struct {
+	// Match is the list of fields which identify an event as a duplicate
+	Match []string `json:"match,omitempty" yaml:"match,omitempty" toml:"match,omitempty"`
+}
+
+type DedupeCache struct {
+	// NumEvents is the number of recently seen events to cache
+	NumEvents uint64 `json:"num_events,omitempty" yaml:"num_events,omitempty" toml:"num_events,omitempty"`
+}
+
+func NewDedupe(init func(*Dedupe), inputs ...string) *Dedupe {
+	sort.Strings(inputs)
+	t := &Dedupe{
+		Type:   types.TransformTypeDedupe,
+		Inputs: inputs,
+	}
+	if init != nil {
+		init(t)
+	}
+	return t
+}
+
+func (t *Dedupe) TransformType() types.TransformType {
+	return t.Type
+}
@@ -50,12 +50,17 @@ const (
 	// MetricsKindIncremental default if not defined
 	MetricsKindIncremental MetricsKind = "incremental"
 
-	MetricsTypeCounter MetricsType = "counter"
+	MetricsTypeCounter   MetricsType = "counter"
+	MetricsTypeGauge     MetricsType = "gauge"
+	MetricsTypeHistogram MetricsType = "histogram"
 )
 
 type Metric struct {
 	Field string `json:"field" yaml:"field" toml:"field"`
 
+	// IncrementByValue increments a counter by the value of the field instead of by one
+	IncrementByValue bool `json:"increment_by_value,omitempty" yaml:"increment_by_value,omitempty" toml:"increment_by_value,omitempty"`
+
 	Kind MetricsKind `json:"kind,omitempty" yaml:"kind,omitempty" toml:"kind,omitempty"`
 
 	MetricName string `json:"name,omitempty" yaml:"name,omitempty" toml:"name,omitempty"`
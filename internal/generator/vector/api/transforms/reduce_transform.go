@@ -7,12 +7,13 @@ import (
 )
 
 type Reduce struct {
-	Type            types.TransformType `json:"type" yaml:"type" toml:"type"`
-	Inputs          []string            `json:"inputs" yaml:"inputs" toml:"inputs"`
-	ExpireAfterMs   uint64              `json:"expire_after_ms,omitempty" yaml:"expire_after_ms,omitempty" toml:"expire_after_ms,omitempty"`
-	MaxEvents       uint64              `json:"max_events,omitempty" yaml:"max_events,omitempty" toml:"max_events,omitempty"`
-	GroupBy         []string            `json:"group_by,omitempty" yaml:"group_by,omitempty" toml:"group_by,omitempty"`
-	MergeStrategies *MergeStrategies    `json:"merge_strategies,omitempty" yaml:"merge_strategies,omitempty" toml:"merge_strategies,omitempty"`
+	Type             types.TransformType `json:"type" yaml:"type" toml:"type"`
+	Inputs           []string            `json:"inputs" yaml:"inputs" toml:"inputs"`
+	ExpireAfterMs    uint64              `json:"expire_after_ms,omitempty" yaml:"expire_after_ms,omitempty" toml:"expire_after_ms,omitempty"`
+	EndEveryPeriodMs uint64              `json:"end_every_period_ms,omitempty" yaml:"end_every_period_ms,omitempty" toml:"end_every_period_ms,omitempty"`
+	MaxEvents        uint64              `json:"max_events,omitempty" yaml:"max_events,omitempty" toml:"max_events,omitempty"`
+	GroupBy          []string            `json:"group_by,omitempty" yaml:"group_by,omitempty" toml:"group_by,omitempty"`
+	MergeStrategies  *MergeStrategies    `json:"merge_strategies,omitempty" yaml:"merge_strategies,omitempty" toml:"merge_strategies,omitempty"`
 }
 
 type MergeStrategiesResourceType string
@@ -23,9 +24,22 @@ const (
 	MergeStrategiesLogRecordsArray MergeStrategiesLogRecordsType = "array"
 )
 
+type MergeStrategy string
+
+const (
+	// MergeStrategyDiscard keeps the value of the first event
+	MergeStrategyDiscard MergeStrategy = "discard"
+	// MergeStrategySum adds the values of the events
+	MergeStrategySum MergeStrategy = "sum"
+)
+
 type MergeStrategies struct {
 	Resource   MergeStrategiesResourceType   `json:"resource,omitempty" yaml:"resource,omitempty" toml:"resource,omitempty"`
 	LogRecords MergeStrategiesLogRecordsType `json:"logRecords,omitempty" yaml:"logRecords,omitempty" toml:"logRecords,omitempty"`
+
+	// DedupeRecord and DedupeCount are the fields of the records wrapped by a dedupe filter
+	DedupeRecord MergeStrategy `json:"dedupe_record,omitempty" yaml:"dedupe_record,omitempty" toml:"dedupe_record,omitempty"`
+	DedupeCount  MergeStrategy `json:"dedupe_count,omitempty" yaml:"dedupe_count,omitempty" toml:"dedupe_count,omitempty"`
 }
 
 func NewReduce(init func(*Reduce), inputs ...string) *Reduce {
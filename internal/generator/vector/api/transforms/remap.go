@@ -17,6 +17,9 @@ type Remap struct {
 
 	// Source is the VRL script used for the remap transformation
 	Source VrlString `json:"source" yaml:"source" toml:"source" multiline:"true" literal:"true"`
+
+	// RerouteDropped routes the events aborted by the script to the `dropped` output instead of dropping them
+	RerouteDropped bool `json:"reroute_dropped,omitempty" yaml:"reroute_dropped,omitempty" toml:"reroute_dropped,omitempty"`
 }
 
 func NewRemap(source string, inputs ...string) *Remap {
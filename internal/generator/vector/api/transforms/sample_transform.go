@@ -0,0 +1,42 @@
+package transforms
+
+import (
+	"sort"
+
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+)
+
+type Sample struct {
+	Type types.TransformType `json:"type" yaml:"type" toml:"type"`
+
+	// Inputs is the IDs of the components feeding into this component
+	Inputs []string `json:"inputs" yaml:"inputs" toml:"inputs"`
+
+	// Rate keeps 1 out of every N events
+	Rate uint64 `json:"rate,omitempty" yaml:"rate,omitempty" toml:"rate,omitempty"`
+
+	// Ratio is the fraction of events to keep, mutually exclusive with Rate
+	Ratio float64 `json:"ratio,omitempty" yaml:"ratio,omitempty" toml:"ratio,omitempty"`
+
+	// KeyField is the field whose value is hashed to make the sampling decision consistent per value
+	KeyField string `json:"key_field,omitempty" yaml:"key_field,omitempty" toml:"key_field,omitempty"`
+
+	// Exclude is the VRL condition of events that are never sampled
+	Exclude Condition `json:"exclude,omitempty" yaml:"exclude,omitempty" toml:"exclude,omitempty" multiline:"true" literal:"true"`
+}
+
+func NewSample(init func(*Sample), inputs ...string) *Sample {
+	sort.Strings(inputs)
+	t := &Sample{
+		Type:   types.TransformTypeSample,
+		Inputs: inputs,
+	}
+	if init != nil {
+		init(t)
+	}
+	return t
+}
+
+func (t *Sample) TransformType() types.TransformType {
+	return t.Type
+}
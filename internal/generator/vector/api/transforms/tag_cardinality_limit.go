@@ -0,0 +1,48 @@
+package transforms
+
+import (
+	"sort"
+
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+)
+
+type TagCardinalityLimitMode string
+
+type TagCardinalityLimitAction string
+
+const (
+	TagCardinalityLimitModeExact TagCardinalityLimitMode = "exact"
+
+	// TagCardinalityLimitActionDropTag removes the tag from a metric with a new tag value once the limit is reached
+	TagCardinalityLimitActionDropTag TagCardinalityLimitAction = "drop_tag"
+)
+
+// TagCardinalityLimit is the configuration for the tag_cardinality_limit transform
+type TagCardinalityLimit struct {
+	Type types.TransformType `json:"type" yaml:"type" toml:"type"`
+
+	// Inputs is the IDs of the components feeding into this component
+	Inputs []string `json:"inputs" yaml:"inputs" toml:"inputs"`
+
+	Mode TagCardinalityLimitMode `json:"mode,omitempty" yaml:"mode,omitempty" toml:"mode,omitempty"`
+
+	// ValueLimit is the maximum number of distinct values of each tag
+	ValueLimit uint64 `json:"value_limit,omitempty" yaml:"value_limit,omitempty" toml:"value_limit,omitempty"`
+
+	LimitExceededAction TagCardinalityLimitAction `json:"limit_exceeded_action,omitempty" yaml:"limit_exceeded_action,omitempty" toml:"limit_exceeded_action,omitempty"`
+}
+
+func NewTagCardinalityLimit(valueLimit uint64, inputs ...string) *TagCardinalityLimit {
+	sort.Strings(inputs)
+	return &TagCardinalityLimit{
+		Type:                types.TransformTypeTagCardinalityLimit,
+		Inputs:              inputs,
+		Mode:                TagCardinalityLimitModeExact,
+		ValueLimit:          valueLimit,
+		LimitExceededAction: TagCardinalityLimitActionDropTag,
+	}
+}
+
+func (t *TagCardinalityLimit) TransformType() types.TransformType {
+	return t.Type
+}
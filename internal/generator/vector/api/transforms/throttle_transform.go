@@ -15,6 +15,13 @@ type Throttle struct {
 	WindowSecs uint64 `json:"window_secs,omitempty" yaml:"window_secs,omitempty" toml:"window_secs,omitempty"`
 	Threshold  uint64 `json:"threshold,omitempty" yaml:"threshold,omitempty" toml:"threshold,omitempty"`
 	KeyField   string `json:"key_field,omitempty" yaml:"key_field,omitempty" toml:"key_field,omitempty"`
+
+	InternalMetrics *ThrottleInternalMetrics `json:"internal_metrics,omitempty" yaml:"internal_metrics,omitempty" toml:"internal_metrics,omitempty"`
+}
+
+type ThrottleInternalMetrics struct {
+	// EmitEventsDiscardedPerKey emits the events_discarded_total metric tagged with the throttle key
+	EmitEventsDiscardedPerKey bool `json:"emit_events_discarded_per_key,omitempty" yaml:"emit_events_discarded_per_key,omitempty" toml:"emit_events_discarded_per_key,omitempty"`
 }
 
 func NewThrottle(init func(*Throttle), inputs ...string) *Throttle {
@@ -3,5 +3,7 @@ package codec
 type CodecType string
 
 const (
-	CodecTypeJSON CodecType = "json"
+	CodecTypeBytes CodecType = "bytes"
+	CodecTypeJSON  CodecType = "json"
+	CodecTypeText  CodecType = "text"
 )
@@ -5,9 +5,12 @@ type SinkType string
 const (
 	SinkTypeAwsCloudwatchLogs  SinkType = "aws_cloudwatch_logs"
 	SinkTypeAwsS3              SinkType = "aws_s3"
+	SinkTypeAzureBlob          SinkType = "azure_blob"
 	SinkTypeAzureLogsIngestion SinkType = "azure_logs_ingestion"
 	SinkTypeAzureMonitorLogs   SinkType = "azure_monitor_logs"
+	SinkTypeBlackhole          SinkType = "blackhole"
 	SinkTypeElasticsearch      SinkType = "elasticsearch"
+	SinkTypeGcpCloudStorage    SinkType = "gcp_cloud_storage"
 	SinkTypeGcpStackdriverLogs SinkType = "gcp_stackdriver_logs"
 	SinkTypeHttp               SinkType = "http"
 	SinkTypeLoki               SinkType = "loki"
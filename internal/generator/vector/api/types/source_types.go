@@ -3,11 +3,15 @@ package types
 type SourceType string
 
 const (
+	SourceTypeDemoLogs        SourceType = "demo_logs"
 	SourceTypeFile            SourceType = "file"
 	SourceTypeHttpServer      SourceType = "http_server"
+	SourceTypeInternalLogs    SourceType = "internal_logs"
 	SourceTypeInternalMetrics SourceType = "internal_metrics"
 	SourceTypeKubernetesLogs  SourceType = "kubernetes_logs"
 	SourceTypeJournald        SourceType = "journald"
+	SourceTypeKafka           SourceType = "kafka"
+	SourceTypeOpenTelemetry   SourceType = "opentelemetry"
 	SourceTypeSyslog          SourceType = "syslog"
 )
 
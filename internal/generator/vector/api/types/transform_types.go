@@ -3,13 +3,16 @@ package types
 type TransformType string
 
 const (
-	TransformTypeDetectExceptions TransformType = "detect_exceptions"
-	TransformTypeFilter           TransformType = "filter"
-	TransformTypeLogToMetric      TransformType = "log_to_metric"
-	TransformTypeReduce           TransformType = "reduce"
-	TransformTypeRemap            TransformType = "remap"
-	TransformTypeRoute            TransformType = "route"
-	TransformTypeThrottle         TransformType = "throttle"
+	TransformTypeDedupe              TransformType = "dedupe"
+	TransformTypeDetectExceptions    TransformType = "detect_exceptions"
+	TransformTypeFilter              TransformType = "filter"
+	TransformTypeLogToMetric         TransformType = "log_to_metric"
+	TransformTypeReduce              TransformType = "reduce"
+	TransformTypeRemap               TransformType = "remap"
+	TransformTypeRoute               TransformType = "route"
+	TransformTypeSample              TransformType = "sample"
+	TransformTypeTagCardinalityLimit TransformType = "tag_cardinality_limit"
+	TransformTypeThrottle            TransformType = "throttle"
 )
 
 type Transform interface {
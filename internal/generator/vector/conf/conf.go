@@ -16,6 +16,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/factory"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/enrichip"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/metrics"
 	corev1 "k8s.io/api/core/v1"
@@ -84,7 +85,7 @@ func Conf(secrets map[string]*corev1.Secret, clfspec obs.ClusterLogForwarderSpec
 		outputMap[spec.Name] = o
 	}
 
-	filters := filter.NewInternalFilterMap(internalobs.FilterMap(clfspec))
+	filters := filter.NewInternalFilterMap(internalobs.FilterMap(clfspec), op)
 	pipelineMap := map[string]*adapters.Pipeline{}
 	for i, p := range clfspec.Pipelines {
 		a := adapters.NewPipeline(i, p, inputCompMap, outputMap, filters, clfspec.Inputs, adapters.AddSystemFilters)
@@ -95,6 +96,7 @@ func Conf(secrets map[string]*corev1.Secret, clfspec obs.ClusterLogForwarderSpec
 		Global(c, namespace, forwarderName)
 		c.Sources[InternalMetricsSourceName] = sources.NewInternalMetrics()
 	})
+	config.AddEnrichmentTables(enrichip.NewTables(clfspec.Filters))
 	for _, i := range sortAdapters(inputMap) {
 		sources, transforms := input.NewSource(i, resNames, secrets, op)
 		config.AddSources(sources)
@@ -106,6 +108,7 @@ func Conf(secrets map[string]*corev1.Secret, clfspec obs.ClusterLogForwarderSpec
 			return nil, fmt.Errorf("generating pipeline transforms: %w", err)
 		}
 		config.AddTransforms(transforms)
+		op.AddToStringSet(framework.OptionLogsToMetricInputs, p.MetricsIDs()...)
 	}
 	for _, o := range sortAdapters(outputMap) {
 		sinks, transforms := output.New(o, o.InputIDs, secrets, op)
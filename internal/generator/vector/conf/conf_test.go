@@ -11,6 +11,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/factory"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	"github.com/openshift/cluster-logging-operator/internal/tls"
+	"github.com/openshift/cluster-logging-operator/internal/utils/toml"
 	. "github.com/openshift/cluster-logging-operator/test/matchers"
 	corev1 "k8s.io/api/core/v1"
 )
@@ -177,4 +178,51 @@ var _ = Describe("Testing Complete Config Generation", func() {
 				},
 			}),
 	)
+
+	It("should load the CIDR table of an enrichIP filter looked up by the filter", func() {
+		spec := obs.ClusterLogForwarderSpec{
+			Inputs: []obs.InputSpec{
+				{
+					Name:  string(obs.InputTypeAudit),
+					Type:  obs.InputTypeAudit,
+					Audit: &obs.Audit{},
+				},
+			},
+			Filters: []obs.FilterSpec{
+				{
+					Name: "my-zones",
+					Type: obs.FilterTypeEnrichIP,
+					EnrichIPFilterSpec: &obs.EnrichIPFilterSpec{
+						Fields:    []obs.FieldPath{".sourceIPs"},
+						CIDRTable: obs.ValueReference{Key: "zones.csv", ConfigMapName: "network-zones"},
+					},
+				},
+			},
+			Pipelines: []obs.PipelineSpec{
+				{
+					Name:       "pipeline",
+					InputRefs:  []string{string(obs.InputTypeAudit)},
+					FilterRefs: []string{"my-zones"},
+					OutputRefs: []string{outputName},
+				},
+			},
+			Outputs: []obs.OutputSpec{kafkaOutput},
+		}
+		conf, err := Conf(secrets, spec, constants.OpenshiftNS, "my-forwarder", factory.ForwarderResourceNames{CommonName: constants.CollectorName}, clusterOptions)
+		Expect(err).ToNot(HaveOccurred())
+
+		loaded := map[string]interface{}{}
+		Expect(toml.Unmarshal(toml.MustMarshal(conf), &loaded)).To(Succeed())
+		Expect(loaded).To(HaveKeyWithValue("enrichment_tables", HaveKeyWithValue("enrichip_my_zones", map[string]interface{}{
+			"type": "file",
+			"file": map[string]interface{}{
+				"path":     "/var/run/ocp-collector/config/network-zones/zones.csv",
+				"encoding": map[string]interface{}{"type": "csv", "include_headers": true},
+			},
+		})))
+		Expect(loaded).To(HaveKeyWithValue("transforms", ContainElement(And(
+			HaveKeyWithValue("type", "remap"),
+			HaveKeyWithValue("source", ContainSubstring(`find_enrichment_table_records("enrichip_my_zones", {"cidr": subnet + prefix}, case_sensitive: true)`)),
+		))))
+	})
 })
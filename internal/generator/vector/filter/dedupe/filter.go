@@ -0,0 +1,85 @@
+package dedupe
+
+import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+const (
+	DefaultCacheSize     = 1000
+	DefaultWindowSeconds = 10
+
+	// SuppressedCountField is the field of the emitted record holding the number of suppressed duplicates
+	SuppressedCountField = "dedupe_suppressed_count"
+
+	keyedRoute = "keyed"
+
+	// restoreVRL unwraps the first record of a window and annotates it with the number of suppressed duplicates.
+	// Records without any of the identity fields are not wrapped and pass unchanged
+	restoreVRL = `
+if exists(.dedupe_record) {
+  count = to_int(.dedupe_count) ?? 1
+  . = object(.dedupe_record) ?? {}
+  if count > 1 {
+    .` + SuppressedCountField + ` = count - 1
+    ._internal.` + SuppressedCountField + ` = count - 1
+  }
+}
+`
+)
+
+// New returns the transforms collapsing the duplicate records of a window. Records with at least one of the identity
+// fields are wrapped with their identity and reduced by identity, keeping the first record and the number of records.
+// The transform identified by id unwraps the first record and adds the count of the suppressed duplicates
+func New(spec *obs.DedupeFilterSpec, id string, inputs ...string) api.Transforms {
+	keyID := helpers.MakeID(id, "key")
+	routeID := helpers.MakeID(id, "route")
+	reduceID := helpers.MakeID(id, "reduce")
+
+	windowMs := uint64(DefaultWindowSeconds * 1000)
+	if spec.WindowSeconds > 0 {
+		windowMs = uint64(spec.WindowSeconds * 1000)
+	}
+	cacheSize := uint64(DefaultCacheSize)
+	if spec.CacheSize > 0 {
+		cacheSize = uint64(spec.CacheSize)
+	}
+	return api.Transforms{
+		keyID: transforms.NewRemap(KeyVRL(spec.Fields), inputs...),
+		routeID: transforms.NewRoute(func(r *transforms.Route) {
+			r.Routes = map[string]string{keyedRoute: "exists(.dedupe_key)"}
+		}, keyID),
+		reduceID: transforms.NewReduce(func(r *transforms.Reduce) {
+			r.GroupBy = []string{"dedupe_key"}
+			r.ExpireAfterMs = windowMs
+			r.EndEveryPeriodMs = windowMs
+			r.MaxEvents = cacheSize
+			r.MergeStrategies = &transforms.MergeStrategies{
+				DedupeRecord: transforms.MergeStrategyDiscard,
+				DedupeCount:  transforms.MergeStrategySum,
+			}
+		}, helpers.MakeRouteInputID(routeID, keyedRoute)),
+		id: transforms.NewRemap(restoreVRL, reduceID, transforms.UnmatchedRoute(routeID)),
+	}
+}
+
+// KeyVRL wraps the record with the identity computed from the internal values of the fields. The record is kept as
+// an object so its values and metadata are unchanged. Records without any of the fields are not wrapped
+func KeyVRL(fields []obs.FieldPath) string {
+	values := make([]string, len(fields))
+	exists := make([]string, len(fields))
+	for i, f := range fields {
+		values[i] = fmt.Sprintf("._internal%s", f)
+		exists[i] = fmt.Sprintf("exists(._internal%s)", f)
+	}
+	return fmt.Sprintf(`
+if %s {
+  . = {"dedupe_key": encode_json([%s]), "dedupe_count": 1, "dedupe_record": .}
+}
+`, strings.Join(exists, " || "), strings.Join(values, ", "))
+}
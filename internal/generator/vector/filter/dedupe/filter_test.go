@@ -0,0 +1,41 @@
+package dedupe
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	"github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("dedupe filter", func() {
+
+	DescribeTable("#New", func(spec obs.DedupeFilterSpec, expFile string) {
+		exp, err := tomlContent.ReadFile(expFile)
+		if err != nil {
+			Fail(fmt.Sprintf("Error reading the file %q with exp config: %v", expFile, err))
+		}
+		Expect(exp).To(matchers.EqualConfigFrom(api.NewConfig(func(c *api.Config) {
+			c.Transforms = New(&spec, "my_dedupe", "application")
+		})))
+	},
+		Entry("with defaults", obs.DedupeFilterSpec{
+			Fields: []obs.FieldPath{".message"},
+		}, "dedupe_defaults.toml"),
+		Entry("with cache size and window", obs.DedupeFilterSpec{
+			Fields:        []obs.FieldPath{".kubernetes.namespace_name", `.kubernetes.labels."app.kubernetes.io/name"`, ".message"},
+			CacheSize:     50,
+			WindowSeconds: 60,
+		}, "dedupe_window.toml"),
+	)
+
+	It("should key the records with any of the fields by their internal values", func() {
+		Expect(KeyVRL([]obs.FieldPath{".message", ".level"})).To(matchers.EqualTrimLines(`
+if exists(._internal.message) || exists(._internal.level) {
+  . = {"dedupe_key": encode_json([._internal.message, ._internal.level]), "dedupe_count": 1, "dedupe_record": .}
+}
+`))
+	})
+})
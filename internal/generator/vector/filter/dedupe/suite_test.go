@@ -0,0 +1,19 @@
+package dedupe
+
+import (
+	"embed"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var (
+	//go:embed *.toml
+	tomlContent embed.FS
+)
+
+func TestDedupeFilter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "[filter][dedupe] Unit Tests")
+}
@@ -41,7 +41,8 @@ func buildMatchCondition(field, pattern string, negate bool) (string, error) {
 	return fmt.Sprintf(`%smatch(to_string(%s) ?? "", r'%s')`, prefix, field, pattern), nil
 }
 
-func (f *Filter) VRL() (string, error) {
+// Condition returns the VRL expression that evaluates to true when any test passes
+func (f *Filter) Condition() (string, error) {
 	vrlTests := []string{}
 	for _, test := range f.tests {
 		condList := []string{}
@@ -65,8 +66,15 @@ func (f *Filter) VRL() (string, error) {
 		vrlCondition := "(" + strings.Join(condList, " && ") + ")"
 		vrlTests = append(vrlTests, vrlCondition)
 	}
+	return strings.Join(vrlTests, " || "), nil
+}
 
+func (f *Filter) VRL() (string, error) {
+	condition, err := f.Condition()
+	if err != nil {
+		return "", err
+	}
 	// Vector's transform.Filter keeps logs that match the condition
 	// Need `!()` to negate the whole expression if any condition evaluates to TRUE to drop logs
-	return "!(" + strings.Join(vrlTests, " || ") + ")", nil
+	return "!(" + condition + ")", nil
 }
@@ -0,0 +1,193 @@
+package enrichip
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"text/template"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/common/tls"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+)
+
+const (
+	DefaultTarget = ".network_zone"
+
+	// PrefixesOption is the option holding the Prefixes of the CIDR table of each enrichIP filter keyed by the filter name
+	PrefixesOption = "enrichIPPrefixes"
+)
+
+// Prefixes are the distinct prefix lengths of the CIDRs of a table for each address family, from the most specific
+type Prefixes struct {
+	IPv4 []int
+	IPv6 []int
+}
+
+type EnrichIP struct {
+	Fields    []string
+	Table     string
+	Target    string
+	IPv4Masks string
+	IPv6Masks string
+}
+
+var (
+	EnrichIPVRLTemplate = template.Must(template.New("enrichIP VRL").Parse(enrichIPVRLTemplateStr))
+
+	// allPrefixes are looked up when the content of the CIDR table is not known
+	allPrefixes = Prefixes{
+		IPv4: prefixLengths(32),
+		IPv6: prefixLengths(128),
+	}
+
+	//go:embed enrichip.vrl.tmpl
+	enrichIPVRLTemplateStr string
+)
+
+// TableID is the id of the enrichment table holding the CIDRs of the filter
+func TableID(filterName string) string {
+	return helpers.MakeID("enrichip", helpers.FormatComponentID(filterName))
+}
+
+// NewTables returns the enrichment tables loaded from the CIDR tables of the enrichIP filters
+func NewTables(filters []obs.FilterSpec) map[string]*api.EnrichmentTable {
+	tables := map[string]*api.EnrichmentTable{}
+	for _, f := range filters {
+		if f.Type == obs.FilterTypeEnrichIP && f.EnrichIPFilterSpec != nil {
+			tables[TableID(f.Name)] = api.NewCSVFileTable(tls.ValuePath(&f.EnrichIPFilterSpec.CIDRTable, "%s"))
+		}
+	}
+	return tables
+}
+
+// NewPrefixes returns the prefix lengths of the CIDRs of the content of a CIDR table. Rows which are not a valid CIDR
+// are skipped since they never match
+func NewPrefixes(content string) Prefixes {
+	prefixes := Prefixes{}
+	records, err := csv.NewReader(strings.NewReader(content)).ReadAll()
+	if err != nil || len(records) == 0 {
+		return prefixes
+	}
+	cidrColumn := -1
+	for i, name := range records[0] {
+		if strings.TrimSpace(name) == "cidr" {
+			cidrColumn = i
+		}
+	}
+	if cidrColumn < 0 {
+		return prefixes
+	}
+	ipv4, ipv6 := map[int]bool{}, map[int]bool{}
+	for _, record := range records[1:] {
+		if _, network, err := net.ParseCIDR(record[cidrColumn]); err == nil {
+			ones, bits := network.Mask.Size()
+			if bits == net.IPv4len*8 {
+				ipv4[ones] = true
+			} else {
+				ipv6[ones] = true
+			}
+		}
+	}
+	prefixes.IPv4 = sortedLengths(ipv4)
+	prefixes.IPv6 = sortedLengths(ipv6)
+	return prefixes
+}
+
+// TablePrefixes returns the Prefixes of the CIDR tables of the enrichIP filters keyed by the filter name. Tables missing
+// from the secrets and config maps are not included
+func TablePrefixes(filters []obs.FilterSpec, secrets internalobs.Secrets, configMaps internalobs.ConfigMaps) map[string]Prefixes {
+	prefixes := map[string]Prefixes{}
+	for _, f := range filters {
+		if f.Type != obs.FilterTypeEnrichIP || f.EnrichIPFilterSpec == nil {
+			continue
+		}
+		table := f.EnrichIPFilterSpec.CIDRTable
+		if secret, found := secrets[table.SecretName]; found && table.SecretName != "" {
+			if content, found := secret.Data[table.Key]; found {
+				prefixes[f.Name] = NewPrefixes(string(content))
+			}
+		} else if configMap, found := configMaps[table.ConfigMapName]; found && table.ConfigMapName != "" {
+			if content, found := configMap.Data[table.Key]; found {
+				prefixes[f.Name] = NewPrefixes(content)
+			}
+		}
+	}
+	return prefixes
+}
+
+// New returns a remap labeling the record with the CIDR table of the filter. Only the prefix lengths of the table from
+// the PrefixesOption are looked up, or all of them when the table is not known
+func New(filterName string, spec *obs.EnrichIPFilterSpec, op utils.Options, inputs ...string) types.Transform {
+	tables, _ := utils.GetOption(op, PrefixesOption, map[string]Prefixes{})
+	prefixes, found := tables[filterName]
+	if !found {
+		prefixes = allPrefixes
+	}
+	vrl, err := VRL(filterName, spec, prefixes)
+	if err != nil {
+		log.Error(err, "bad filter", "enrichIPFilterSpec", spec)
+		return nil
+	}
+	return transforms.NewRemap(vrl, inputs...)
+}
+
+// prefixLengths returns the prefix lengths from bits down to 0
+func prefixLengths(bits int) []int {
+	lengths := make([]int, 0, bits+1)
+	for i := bits; i >= 0; i-- {
+		lengths = append(lengths, i)
+	}
+	return lengths
+}
+
+// sortedLengths returns the prefix lengths of the set from the most specific
+func sortedLengths(set map[int]bool) []int {
+	lengths := make([]int, 0, len(set))
+	for length := range set {
+		lengths = append(lengths, length)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(lengths)))
+	return lengths
+}
+
+// prefixMasks returns the quoted masks of the prefix lengths
+func prefixMasks(lengths []int) string {
+	masks := make([]string, 0, len(lengths))
+	for _, length := range lengths {
+		masks = append(masks, fmt.Sprintf(`"/%d"`, length))
+	}
+	return strings.Join(masks, ", ")
+}
+
+// VRL looks up the IP addresses of the fields in the CIDR table and adds the label of the first match. Each address
+// is looked up by the exact value of the networks containing it for each prefix length of the table, from the most
+// specific, to use the index of the table instead of scanning its rows
+func VRL(filterName string, spec *obs.EnrichIPFilterSpec, prefixes Prefixes) (string, error) {
+	e := EnrichIP{
+		Table:     TableID(filterName),
+		Target:    DefaultTarget,
+		IPv4Masks: prefixMasks(prefixes.IPv4),
+		IPv6Masks: prefixMasks(prefixes.IPv6),
+	}
+	if spec.Target != "" {
+		e.Target = string(spec.Target)
+	}
+	for _, f := range spec.Fields {
+		e.Fields = append(e.Fields, fmt.Sprintf("._internal%s", f))
+	}
+
+	// Execute Go template to generate VRL
+	w := &strings.Builder{}
+	err := EnrichIPVRLTemplate.Execute(w, e)
+	return w.String(), err
+}
@@ -0,0 +1,153 @@
+package enrichip
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("enrichIP filter", func() {
+
+	It("should label the record with the first matching CIDR of the fields", func() {
+		spec := &obs.EnrichIPFilterSpec{
+			Fields: []obs.FieldPath{".sourceIPs", `.structured."src-ip"`},
+			CIDRTable: obs.ValueReference{
+				Key:           "zones.csv",
+				ConfigMapName: "network-zones",
+			},
+		}
+		exp := `
+ips = []
+value = ._internal.sourceIPs
+if is_array(value) {
+  ips = append(ips, array!(value))
+} else if is_string(value) {
+  ips = push(ips, value)
+}
+value = ._internal.structured."src-ip"
+if is_array(value) {
+  ips = append(ips, array!(value))
+} else if is_string(value) {
+  ips = push(ips, value)
+}
+label = null
+for_each(ips) -> |_index, ip| {
+  address = string(ip) ?? ""
+  masks = ["/24", "/16", "/0"]
+  if contains(address, ":") {
+    masks = ["/64"]
+  }
+  for_each(masks) -> |_mask_index, mask| {
+    if label == null {
+      prefix = string(mask) ?? ""
+      subnet = ip_subnet(address, prefix) ?? ""
+      if subnet != "" {
+        rows = find_enrichment_table_records("enrichip_my_zones", {"cidr": subnet + prefix}, case_sensitive: true) ?? []
+        if length(rows) > 0 {
+          label = rows[0].label
+        }
+      }
+    }
+  }
+}
+if label != null {
+  .network_zone = label
+  ._internal.network_zone = label
+}
+`
+		vrl, err := VRL("my-zones", spec, Prefixes{IPv4: []int{24, 16, 0}, IPv6: []int{64}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vrl).To(EqualTrimLines(exp))
+	})
+
+	It("should look up the distinct prefix lengths of the table from the most specific", func() {
+		Expect(NewPrefixes(`label,cidr
+east,10.0.0.0/16
+west,10.1.0.0/16
+office,192.168.1.0/24
+external,0.0.0.0/0
+lab,fd00::/64
+invalid,10.0.0.0
+`)).To(Equal(Prefixes{IPv4: []int{24, 16, 0}, IPv6: []int{64}}))
+	})
+
+	It("should look up every prefix length when the table is not known", func() {
+		Expect(prefixMasks(prefixLengths(2))).To(Equal(`"/2", "/1", "/0"`))
+		transform := New("my-zones", &obs.EnrichIPFilterSpec{Fields: []obs.FieldPath{".sourceIPs"}}, utils.Options{}, "application")
+		Expect(string(transform.(*transforms.Remap).Source)).To(And(
+			ContainSubstring(`masks = ["/32", "/31", `),
+			ContainSubstring(`masks = ["/128", "/127", `),
+		))
+	})
+
+	It("should look up the prefix lengths of the table from the options", func() {
+		op := utils.Options{PrefixesOption: map[string]Prefixes{"my-zones": {IPv4: []int{16}}}}
+		transform := New("my-zones", &obs.EnrichIPFilterSpec{Fields: []obs.FieldPath{".sourceIPs"}}, op, "application")
+		Expect(string(transform.(*transforms.Remap).Source)).To(And(
+			ContainSubstring(`masks = ["/16"]`),
+			ContainSubstring(`masks = []`),
+		))
+	})
+
+	It("should write the label to the target", func() {
+		spec := &obs.EnrichIPFilterSpec{
+			Fields: []obs.FieldPath{".sourceIPs"},
+			Target: ".zone.name",
+		}
+		vrl, err := VRL("my-zones", spec, allPrefixes)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vrl).To(ContainSubstring(".zone.name = label\n  ._internal.zone.name = label"))
+	})
+
+	It("should load a table for each enrichIP filter from the referenced key", func() {
+		filters := []obs.FilterSpec{
+			{
+				Name: "my-zones",
+				Type: obs.FilterTypeEnrichIP,
+				EnrichIPFilterSpec: &obs.EnrichIPFilterSpec{
+					Fields: []obs.FieldPath{".sourceIPs"},
+					CIDRTable: obs.ValueReference{
+						Key:           "zones.csv",
+						ConfigMapName: "network-zones",
+					},
+				},
+			},
+			{
+				Name: "my-secret-zones",
+				Type: obs.FilterTypeEnrichIP,
+				EnrichIPFilterSpec: &obs.EnrichIPFilterSpec{
+					Fields: []obs.FieldPath{".sourceIPs"},
+					CIDRTable: obs.ValueReference{
+						Key:        "zones.csv",
+						SecretName: "network-zones",
+					},
+				},
+			},
+			{
+				Name: "my-drop",
+				Type: obs.FilterTypeDrop,
+			},
+		}
+		Expect(NewTables(filters)).To(Equal(map[string]*api.EnrichmentTable{
+			"enrichip_my_zones":        api.NewCSVFileTable("/var/run/ocp-collector/config/network-zones/zones.csv"),
+			"enrichip_my_secret_zones": api.NewCSVFileTable("/var/run/ocp-collector/secrets/network-zones/zones.csv"),
+		}))
+
+		secrets := internalobs.Secrets{
+			"network-zones": {Data: map[string][]byte{"zones.csv": []byte("cidr,label\nfd00::/64,lab\n")}},
+		}
+		configMaps := internalobs.ConfigMaps{
+			"network-zones": {Data: map[string]string{"zones.csv": "cidr,label\n10.0.0.0/16,east\n"}},
+		}
+		Expect(TablePrefixes(filters, secrets, configMaps)).To(Equal(map[string]Prefixes{
+			"my-zones":        {IPv4: []int{16}, IPv6: []int{}},
+			"my-secret-zones": {IPv4: []int{}, IPv6: []int{64}},
+		}))
+		Expect(TablePrefixes(filters, nil, nil)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,13 @@
+package enrichip
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestEnrichIPFilter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "[filter][enrichip] Unit Tests")
+}
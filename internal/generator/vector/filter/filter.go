@@ -6,18 +6,28 @@ import (
 	log "github.com/ViaQ/logerr/v2/log/static"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/multilineexception"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/parse"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
 
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/dedupe"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/drop"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/enrichip"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/kubernetesmetadata"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/logtometric"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/openshift"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/prune"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/redact"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/sample"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/throttle"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/transform"
 
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/apiaudit"
 )
 
-func NewInternalFilterMap(filters map[string]*obs.FilterSpec) map[string]*adapters.InternalFilterSpec {
+func NewInternalFilterMap(filters map[string]*obs.FilterSpec, op utils.Options) map[string]*adapters.InternalFilterSpec {
 	internalFilters := map[string]*adapters.InternalFilterSpec{}
 	for _, f := range filters {
 		internalFilter := &adapters.InternalFilterSpec{FilterSpec: f}
@@ -26,24 +36,62 @@ func NewInternalFilterMap(filters map[string]*obs.FilterSpec) map[string]*adapte
 			internalFilter.Factory = func(inputs ...string) types.Transform {
 				return openshift.New(f.OpenshiftLabels, inputs...)
 			}
+		case obs.FilterTypeDedupe:
+			internalFilter.ChainFactory = func(id string, inputs ...string) api.Transforms {
+				return dedupe.New(f.DedupeFilterSpec, id, inputs...)
+			}
 		case obs.FilterTypeDrop:
 			internalFilter.Factory = func(inputs ...string) types.Transform {
 				return drop.New(f.DropTestsSpec, inputs...)
 			}
+		case obs.FilterTypeEnrichIP:
+			internalFilter.Factory = func(inputs ...string) types.Transform {
+				return enrichip.New(f.Name, f.EnrichIPFilterSpec, op, inputs...)
+			}
 		case obs.FilterTypePrune:
 			internalFilter.Factory = func(inputs ...string) types.Transform {
 				return prune.New(f.PruneFilterSpec, inputs...)
 			}
+		case obs.FilterTypeRedact:
+			internalFilter.Factory = func(inputs ...string) types.Transform {
+				return redact.New(f.RedactFilterSpec, inputs...)
+			}
+		case obs.FilterTypeSample:
+			internalFilter.Factory = func(inputs ...string) types.Transform {
+				return sample.New(f.SampleFilterSpec, inputs...)
+			}
+		case obs.FilterTypeThrottle:
+			internalFilter.Factory = func(inputs ...string) types.Transform {
+				return throttle.New(f.ThrottleFilterSpec, inputs...)
+			}
+		case obs.FilterTypeLogToMetric:
+			internalFilter.Factory = func(inputs ...string) types.Transform {
+				return logtometric.New(f.LogToMetricFilterSpec, inputs...)
+			}
+			internalFilter.MetricsFactory = func(id string, inputs ...string) api.Transforms {
+				return logtometric.NewMetrics(f.LogToMetricFilterSpec, id, inputs...)
+			}
+		case obs.FilterTypeKubernetesMetadata:
+			internalFilter.Factory = func(inputs ...string) types.Transform {
+				return kubernetesmetadata.New(f.KubernetesMetadataFilterSpec, inputs...)
+			}
 		case obs.FilterTypeKubeAPIAudit:
 			internalFilter.Factory = func(inputs ...string) types.Transform {
 				return apiaudit.New(f.KubeAPIAudit, inputs...)
 			}
 		case obs.FilterTypeParse:
 			internalFilter.Factory = func(inputs ...string) types.Transform {
+				if f.ParseFilterSpec != nil {
+					return parse.NewFormat(f.ParseFilterSpec, inputs...)
+				}
 				return parse.New(inputs...)
 			}
 		case obs.FilterTypeDetectMultiline:
 			internalFilter.Factory = multilineexception.New
+		case obs.FilterTypeTransform:
+			internalFilter.Factory = func(inputs ...string) types.Transform {
+				return transform.New(f.TransformFilterSpec, inputs...)
+			}
 		default:
 			log.V(0).Error(fmt.Errorf("unknown filter type: %v", f.Type), "This should have been caught by declarative API validation")
 		}
@@ -0,0 +1,57 @@
+package kubernetesmetadata
+
+import (
+	_ "embed"
+	"strings"
+	"text/template"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	viaq "github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/openshift/viaq/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+type KubernetesMetadata struct {
+	Annotations  string
+	DedotKey     string
+	IncludeOwner bool
+}
+
+var (
+	KubernetesMetadataVRLTemplate = template.Must(template.New("kubernetesMetadata VRL").Parse(kubernetesMetadataVRLTemplateStr))
+
+	//go:embed kubernetesmetadata.vrl.tmpl
+	kubernetesMetadataVRLTemplateStr string
+)
+
+// New returns a remap selecting the annotations and resolving the owner workload of container log records
+func New(spec *obs.KubernetesMetadataFilterSpec, inputs ...string) types.Transform {
+	vrl, err := VRL(spec)
+	if err != nil {
+		log.Error(err, "bad filter", "kubernetesMetadataFilterSpec", spec)
+		return nil
+	}
+	return transforms.NewRemap(vrl, inputs...)
+}
+
+// VRL keeps the allowed annotations, dedotting their keys on the root of the record like labels, and adds the owner workload
+func VRL(spec *obs.KubernetesMetadataFilterSpec) (string, error) {
+	km := KubernetesMetadata{
+		DedotKey:     viaq.VRLDedotKey,
+		IncludeOwner: spec.IncludeOwner,
+	}
+	if len(spec.Annotations) > 0 {
+		keys := make([]string, len(spec.Annotations))
+		for i, a := range spec.Annotations {
+			keys[i] = helpers.VRLString(a)
+		}
+		km.Annotations = "[" + strings.Join(keys, ", ") + "]"
+	}
+
+	// Execute Go template to generate VRL
+	w := &strings.Builder{}
+	err := KubernetesMetadataVRLTemplate.Execute(w, km)
+	return w.String(), err
+}
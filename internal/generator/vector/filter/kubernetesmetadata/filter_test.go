@@ -0,0 +1,75 @@
+package kubernetesmetadata
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("kubernetesMetadata filter", func() {
+
+	It("should keep and dedot the allowed annotations", func() {
+		vrl, err := VRL(&obs.KubernetesMetadataFilterSpec{
+			Annotations: []string{"openshift.io/scc", "app.example.com/team"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vrl).To(EqualTrimLines(`
+if ._internal.log_source == "container" {
+  allowed = ["openshift.io/scc", "app.example.com/team"]
+  selected = {}
+  dedotted = {}
+  for_each(object(._internal.kubernetes.annotations) ?? {}) -> |key, value| {
+    if includes(allowed, key) {
+      selected = set!(selected, [key], value)
+      dedotted = set!(dedotted, [replace(key, r'[\./]', "_")], value)
+    }
+  }
+  if is_empty(selected) {
+    del(._internal.kubernetes.annotations)
+    del(.kubernetes.annotations)
+  } else {
+    ._internal.kubernetes.annotations = selected
+    .kubernetes.annotations = dedotted
+  }
+}
+`))
+	})
+
+	It("should quote the annotation keys as VRL strings", func() {
+		vrl, err := VRL(&obs.KubernetesMetadataFilterSpec{
+			Annotations: []string{"example.com/cost$", `example.com/"quoted"`},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vrl).To(ContainSubstring(`allowed = ["example.com/cost$$", "example.com/\"quoted\""]`))
+	})
+
+	It("should add the owner workload", func() {
+		vrl, err := VRL(&obs.KubernetesMetadataFilterSpec{
+			IncludeOwner: true,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vrl).To(EqualTrimLines(`
+if ._internal.log_source == "container" {
+  owner = split(string(._internal.kubernetes.pod_owner) ?? "", "/", limit: 2)
+  if length(owner) == 2 {
+    kind = string(owner[0]) ?? ""
+    name = string(owner[1]) ?? ""
+    hash = string(._internal.kubernetes.labels."pod-template-hash") ?? ""
+    if kind == "ReplicaSet" && hash != "" && ends_with(name, "-" + hash) {
+      kind = "Deployment"
+      name = slice(name, 0, length(name) - length(hash) - 1) ?? name
+    } else if kind == "Job" {
+      scheduled = parse_regex(name, r'^(?P<cronjob>.+)-(?P<minutes>\d{8})$') ?? {}
+      if scheduled != {} && to_int!(scheduled.minutes) * 60 <= to_unix_timestamp(now()) {
+        kind = "CronJob"
+        name = string!(scheduled.cronjob)
+      }
+    }
+    ._internal.kubernetes.owner = {"kind": kind, "name": name}
+    .kubernetes.owner = ._internal.kubernetes.owner
+  }
+}
+`))
+	})
+})
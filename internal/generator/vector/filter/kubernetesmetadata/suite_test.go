@@ -0,0 +1,13 @@
+package kubernetesmetadata
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestKubernetesMetadataFilter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "[filter][kubernetesmetadata] Unit Tests")
+}
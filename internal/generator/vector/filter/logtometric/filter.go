@@ -0,0 +1,129 @@
+package logtometric
+
+import (
+	_ "embed"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/drop"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+const (
+	// Namespace prefixes the name of the metrics published by the filter
+	Namespace = "logcollector"
+
+	// MetricNamePrefix distinguishes metrics defined by filters from those of the collector
+	MetricNamePrefix = "filter_"
+
+	DefaultTagValueLimit = 500
+
+	valuesPath = "_internal.log_to_metric"
+)
+
+type Metric struct {
+	Name      string
+	Field     string
+	Condition string
+}
+
+type LogToMetric struct {
+	Metrics []Metric
+}
+
+var (
+	LogToMetricVRLTemplate = template.Must(template.New("logToMetric VRL").Parse(logToMetricVRLTemplateStr))
+
+	//go:embed logtometric.vrl.tmpl
+	logToMetricVRLTemplateStr string
+)
+
+type Filter obs.LogToMetricFilterSpec
+
+func NewFilter(spec *obs.LogToMetricFilterSpec) Filter {
+	return Filter(*spec)
+}
+
+// New returns a remap that stages the values of each metric on the record and passes the record on unmodified
+func New(spec *obs.LogToMetricFilterSpec, inputs ...string) types.Transform {
+	vrl, err := NewFilter(spec).VRL()
+	if err != nil {
+		log.Error(err, "bad filter", "logToMetricFilterSpec", spec)
+		return nil
+	}
+	return transforms.NewRemap(vrl, inputs...)
+}
+
+// NewMetrics returns the transforms converting the values staged by the filter to metrics.
+// The transform identified by id limits the cardinality of the tags of the metrics
+func NewMetrics(spec *obs.LogToMetricFilterSpec, id string, inputs ...string) api.Transforms {
+	toMetricID := helpers.MakeID(id, "log_to_metric")
+	valueLimit := uint64(DefaultTagValueLimit)
+	if spec.TagValueLimit > 0 {
+		valueLimit = uint64(spec.TagValueLimit)
+	}
+	return api.Transforms{
+		toMetricID: NewFilter(spec).LogToMetric(inputs...),
+		id:         transforms.NewTagCardinalityLimit(valueLimit, toMetricID),
+	}
+}
+
+// VRL stages the value of each metric in the internal fields of the record when its tests pass
+func (f Filter) VRL() (string, error) {
+	ltm := LogToMetric{}
+	for _, m := range f.Metrics {
+		condition, err := drop.NewFilter(m.Tests).Condition()
+		if err != nil {
+			return "", err
+		}
+		metric := Metric{
+			Name:      m.Name,
+			Condition: condition,
+		}
+		if m.Field != "" {
+			metric.Field = fmt.Sprintf("._internal%s", m.Field)
+		}
+		ltm.Metrics = append(ltm.Metrics, metric)
+	}
+
+	// Execute Go template to generate VRL
+	w := &strings.Builder{}
+	err := LogToMetricVRLTemplate.Execute(w, ltm)
+	return w.String(), err
+}
+
+// LogToMetric converts the values staged by the filter to metrics
+func (f Filter) LogToMetric(inputs ...string) *transforms.LogToMetric {
+	ltm := &transforms.LogToMetric{
+		Type:   types.TransformTypeLogToMetric,
+		Inputs: inputs,
+	}
+	sort.Strings(ltm.Inputs)
+	for _, m := range f.Metrics {
+		metric := transforms.Metric{
+			MetricName: MetricNamePrefix + m.Name,
+			Namespace:  Namespace,
+			Field:      fmt.Sprintf("%s.%s", valuesPath, m.Name),
+			Type:       transforms.MetricsType(m.Type),
+		}
+		if m.Type == obs.LogMetricTypeCounter {
+			metric.Kind = transforms.MetricsKindIncremental
+			metric.IncrementByValue = m.Field != ""
+		}
+		if len(m.Tags) > 0 {
+			metric.Tags = transforms.Tags{}
+			for name, path := range m.Tags {
+				metric.Tags[name] = fmt.Sprintf("{{ _internal%s }}", path)
+			}
+		}
+		ltm.Metrics = append(ltm.Metrics, metric)
+	}
+	return ltm
+}
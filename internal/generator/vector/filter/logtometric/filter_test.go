@@ -0,0 +1,93 @@
+package logtometric
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("logToMetric filter", func() {
+	var (
+		spec = &obs.LogToMetricFilterSpec{
+			Metrics: []obs.LogMetric{
+				{
+					Name: "errors_total",
+					Type: obs.LogMetricTypeCounter,
+					Tests: []obs.DropTest{
+						{
+							DropConditions: []obs.DropCondition{
+								{Field: ".level", Matches: "error"},
+							},
+						},
+					},
+					Tags: map[string]obs.FieldPath{
+						"namespace": ".kubernetes.namespace_name",
+					},
+				},
+				{
+					Name:  "request_duration_ms",
+					Type:  obs.LogMetricTypeHistogram,
+					Field: ".structured.duration_ms",
+				},
+			},
+		}
+	)
+
+	It("should generate VRL staging the value of each metric", func() {
+		Expect(NewFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+del(._internal.log_to_metric)
+if (match(to_string(._internal.level) ?? "", r'error')) {
+  ._internal.log_to_metric.errors_total = 1
+}
+value, err = to_float(._internal.structured.duration_ms)
+if err == null {
+  ._internal.log_to_metric.request_duration_ms = value
+}
+`))
+	})
+
+	It("should generate the metrics with a cardinality limit", func() {
+		Expect(NewMetrics(spec, "pipeline_foo_metrics", "pipeline_foo")).To(Equal(api.Transforms{
+			"pipeline_foo_metrics_log_to_metric": &transforms.LogToMetric{
+				Type:   types.TransformTypeLogToMetric,
+				Inputs: []string{"pipeline_foo"},
+				Metrics: []transforms.Metric{
+					{
+						Field:      "_internal.log_to_metric.errors_total",
+						Kind:       transforms.MetricsKindIncremental,
+						MetricName: "filter_errors_total",
+						Namespace:  "logcollector",
+						Tags:       transforms.Tags{"namespace": "{{ _internal.kubernetes.namespace_name }}"},
+						Type:       transforms.MetricsTypeCounter,
+					},
+					{
+						Field:      "_internal.log_to_metric.request_duration_ms",
+						MetricName: "filter_request_duration_ms",
+						Namespace:  "logcollector",
+						Type:       transforms.MetricsTypeHistogram,
+					},
+				},
+			},
+			"pipeline_foo_metrics": &transforms.TagCardinalityLimit{
+				Type:                types.TransformTypeTagCardinalityLimit,
+				Inputs:              []string{"pipeline_foo_metrics_log_to_metric"},
+				Mode:                transforms.TagCardinalityLimitModeExact,
+				ValueLimit:          DefaultTagValueLimit,
+				LimitExceededAction: transforms.TagCardinalityLimitActionDropTag,
+			},
+		}))
+	})
+
+	It("should increment a counter by the value of the field", func() {
+		ltm := NewFilter(&obs.LogToMetricFilterSpec{
+			Metrics: []obs.LogMetric{
+				{Name: "bytes_total", Type: obs.LogMetricTypeCounter, Field: ".size"},
+			},
+		}).LogToMetric("a")
+		Expect(ltm.Metrics[0].IncrementByValue).To(BeTrue())
+	})
+})
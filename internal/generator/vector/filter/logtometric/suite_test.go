@@ -0,0 +1,13 @@
+package logtometric
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestLogToMetricFilter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "[filter][logtometric] Unit Tests")
+}
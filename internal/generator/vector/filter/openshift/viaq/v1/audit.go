@@ -23,12 +23,39 @@ if err == null {
       ts = parse_timestamp(sp[0],"%s.%3f") ?? ""
       if ts != "" { ._internal.timestamp = ts }
       envelop |= {"record_id": sp[1]}
+      fields = parse_key_value(._internal.message, accept_standalone_key: false) ?? {}
+      if is_string(fields.syscall) { envelop.syscall = fields.syscall }
+      if is_string(fields.exe) { envelop.exe = fields.exe }
+      if is_string(fields.uid) { envelop.uid = fields.uid }
       ._internal |= {"audit.linux" : envelop}
       ._internal.timestamp =  format_timestamp(ts,"%+") ?? ""
   }
 } else {
   log("could not parse host audit msg. err=" + err, rate_limit_secs: 0)
 }
+`
+	// ParseOVNAuditLogs parses the ACL and the flow of an OVN ACL log entry, e.g.
+	// ...|acl_log(ovn_pinctrl0)|INFO|name="deny-all", verdict=drop, severity=alert, direction=to-lport: tcp,...,nw_src=10.0.0.1,nw_dst=10.0.0.2,tp_src=3306,tp_dst=8080
+	ParseOVNAuditLogs = `
+parsed, err = parse_regex(._internal.message, r'\|acl_log\([^)]*\)\|[^|]*\|(?P<acl>.*?)(?:: (?P<protocol>[a-z0-9]+),(?P<flow>.*))?$')
+if err == null {
+  acl = parse_key_value(parsed.acl, field_delimiter: ",") ?? {}
+  flow = parse_key_value(parsed.flow, field_delimiter: ",") ?? {}
+  ovn = {
+    "name": acl.name,
+    "verdict": acl.verdict,
+    "severity": acl.severity,
+    "direction": acl.direction,
+    "protocol": parsed.protocol,
+    "src": flow.nw_src,
+    "dst": flow.nw_dst,
+    "src_port": flow.tp_src,
+    "dst_port": flow.tp_dst
+  }
+  if exists(flow.ipv6_src) { ovn.src = flow.ipv6_src }
+  if exists(flow.ipv6_dst) { ovn.dst = flow.ipv6_dst }
+  ._internal.ovn = compact(ovn)
+}
 `
 	SetK8sAuditLevel       = `.k8s_audit_level = ._internal.structured.level`
 	SetOpenshiftAuditLevel = `.openshift_audit_level = ._internal.structured.level`
@@ -78,5 +105,6 @@ if ._internal.log_type == "%s" && ._internal.log_source == "%s" {
 		strings.Join(helpers.TrimSpaces([]string{
 			SetMessageOnRoot,
 			`.level = ._internal.level`,
+			`if exists(._internal.ovn) { .ovn = ._internal.ovn }`,
 		}), "\n"))
 }
@@ -0,0 +1,21 @@
+package v1
+
+import (
+	"fmt"
+	"strings"
+
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+// eventsLogs sets the message and the kubernetes fields, including the event, of kubernetes events
+func eventsLogs() string {
+	return fmt.Sprintf(`
+if ._internal.log_source == "%s" {
+  %s
+}
+`, internalobs.EventsInputLogSource, strings.Join(helpers.TrimSpaces([]string{
+		`.message = ._internal.message`,
+		`.kubernetes = ._internal.kubernetes`,
+	}), "\n  "))
+}
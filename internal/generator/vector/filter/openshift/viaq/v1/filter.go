@@ -31,8 +31,12 @@ func New(inputs []string, inputSpecs []obs.InputSpec) types.Transform {
 	vrls = containerSource(vrls, inputSpecs)
 	vrls = journalSource(vrls, inputSpecs)
 	vrls = receiverSource(vrls, inputSpecs)
+	vrls = hostFileSource(vrls, inputSpecs)
+	vrls = append(vrls, RemoveKubernetesForNonContainerLogs)
+	// Kubernetes fields of OTLP logs and events are set after removing them from all other non-container logs
+	vrls = otlpReceiverSource(vrls, inputSpecs)
+	vrls = eventsSource(vrls, inputSpecs)
 	vrls = append(vrls,
-		RemoveKubernetesForNonContainerLogs,
 		MergeStructuredIntoRoot,
 		`.timestamp = ._internal.timestamp`,
 		`."@timestamp" = ._internal.timestamp`,
@@ -100,5 +104,32 @@ func receiverSource(vrls []string, inputs internalobs.Inputs) []string {
 	if inputs.HasReceiverSource() {
 		vrls = append(vrls, receiverLogs())
 	}
+	if inputs.HasApplicationReceiverSource() || inputs.HasKafkaSource() {
+		vrls = append(vrls, applicationReceiverLogs())
+	}
+	if inputs.HasKafkaSource() {
+		vrls = append(vrls, kafkaLogs())
+	}
+	return vrls
+}
+
+func hostFileSource(vrls []string, inputs internalobs.Inputs) []string {
+	if inputs.HasHostFileSource() {
+		vrls = append(vrls, hostFileLogs())
+	}
+	return vrls
+}
+
+func otlpReceiverSource(vrls []string, inputs internalobs.Inputs) []string {
+	if inputs.HasOTLPReceiverSource() {
+		vrls = append(vrls, otlpReceiverLogs())
+	}
+	return vrls
+}
+
+func eventsSource(vrls []string, inputs internalobs.Inputs) []string {
+	if inputs.HasEventsSource() {
+		vrls = append(vrls, eventsLogs())
+	}
 	return vrls
 }
@@ -0,0 +1,21 @@
+package v1
+
+import (
+	"fmt"
+	"strings"
+
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+// hostFileLogs sets the message and the path of the file of logs collected by a host file input
+func hostFileLogs() string {
+	return fmt.Sprintf(`
+if ._internal.log_source == "%s" {
+  %s
+}
+`, internalobs.HostFileInputLogSource, strings.Join(helpers.TrimSpaces([]string{
+		`.message = ._internal.message`,
+		`.file = ._internal.file`,
+	}), "\n  "))
+}
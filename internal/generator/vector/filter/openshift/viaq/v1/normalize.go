@@ -152,6 +152,7 @@ if exists(._internal.dedot_namespace_labels) {.kubernetes.namespace_labels = del
 del(.kubernetes.node_labels)
 del(.kubernetes.container_image_id)
 del(.kubernetes.pod_ips)
+del(.kubernetes.pod_owner)
 `
 	RemoveKubernetesForNonContainerLogs = `
 if .log_source != "container" && exists(.kubernetes) {
@@ -3,6 +3,7 @@ package v1
 import (
 	"fmt"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 	"strings"
 )
@@ -22,3 +23,37 @@ func receiverLogsVRL() string {
 		fmt.Sprintf(`.log_source = "%s"`, obs.InfrastructureSourceNode),
 	}), "\n\n")
 }
+
+// applicationReceiverLogs sets the message and structured fields of application logs which are not collected from containers
+func applicationReceiverLogs() string {
+	return fmt.Sprintf(`
+if ._internal.log_type == "%s" && ._internal.log_source != "%s" {
+  %s
+}
+`, obs.InputTypeApplication, logSourceContainer, applicationReceiverLogsVRL())
+}
+
+func applicationReceiverLogsVRL() string {
+	return strings.Join(helpers.TrimSpaces([]string{
+		`if exists(._internal.message) {.message = ._internal.message}`,
+		`if exists(._internal.structured) {.structured = ._internal.structured}`,
+	}), "\n  ")
+}
+
+// otlpReceiverLogs sets the kubernetes fields of OTLP logs mapped from the resource attributes of the log records
+func otlpReceiverLogs() string {
+	return fmt.Sprintf(`
+if ._internal.log_source == "%s" && exists(._internal.kubernetes) {
+  .kubernetes = ._internal.kubernetes
+}
+`, internalobs.OTLPReceiverLogSource)
+}
+
+// kafkaLogs sets the topic, partition and offset of records consumed from Kafka
+func kafkaLogs() string {
+	return fmt.Sprintf(`
+if ._internal.log_source == "%s" {
+  .kafka = ._internal.kafka
+}
+`, internalobs.KafkaInputLogSource)
+}
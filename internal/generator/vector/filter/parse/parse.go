@@ -0,0 +1,128 @@
+package parse
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+	"text/template"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+const (
+	DefaultSource            = ".message"
+	DefaultTarget            = ".structured"
+	DefaultKeyValueDelimiter = "="
+	DefaultFieldDelimiter    = " "
+	DefaultCSVDelimiter      = ","
+)
+
+type Parse struct {
+	Source    string
+	Target    string
+	Function  string
+	Headers   string
+	Delimiter string
+	OnFailure obs.ParseFailurePolicy
+}
+
+var (
+	ParseVRLTemplate = template.Must(template.New("parse VRL").Parse(parseVRLTemplateStr))
+
+	//go:embed parse.vrl.tmpl
+	parseVRLTemplateStr string
+
+	// functions are the VRL functions parsing each format that requires no configuration
+	functions = map[obs.ParseFormat]string{
+		obs.ParseFormatJSON:      "parse_json(value)",
+		obs.ParseFormatLogfmt:    "parse_logfmt(value)",
+		obs.ParseFormatSyslog:    "parse_syslog(value)",
+		obs.ParseFormatCEF:       "parse_cef(value)",
+		obs.ParseFormatCommonLog: "parse_common_log(value)",
+	}
+)
+
+type FormatFilter obs.ParseFilterSpec
+
+func NewFormatFilter(spec *obs.ParseFilterSpec) FormatFilter {
+	return FormatFilter(*spec)
+}
+
+// NewFormat returns a remap that parses a field of the log record in the format of the spec
+func NewFormat(spec *obs.ParseFilterSpec, inputs ...string) types.Transform {
+	vrl, err := NewFormatFilter(spec).VRL()
+	if err != nil {
+		log.Error(err, "bad filter", "parseFilterSpec", spec)
+		return nil
+	}
+	return transforms.NewRemap(vrl, inputs...)
+}
+
+func (f FormatFilter) VRL() (string, error) {
+	parse := Parse{
+		Source:    DefaultSource,
+		Target:    DefaultTarget,
+		OnFailure: obs.ParseFailurePolicyKeep,
+	}
+	if f.Source != "" {
+		parse.Source = string(f.Source)
+	}
+	if f.Target != "" {
+		parse.Target = string(f.Target)
+	}
+	if f.OnFailure != "" {
+		parse.OnFailure = f.OnFailure
+	}
+
+	format := f.Format
+	if format == "" {
+		format = obs.ParseFormatJSON
+	}
+	switch format {
+	case obs.ParseFormatKeyValue:
+		keyValueDelimiter, fieldDelimiter := DefaultKeyValueDelimiter, DefaultFieldDelimiter
+		if f.KeyValue != nil && f.KeyValue.KeyValueDelimiter != "" {
+			keyValueDelimiter = f.KeyValue.KeyValueDelimiter
+		}
+		if f.KeyValue != nil && f.KeyValue.FieldDelimiter != "" {
+			fieldDelimiter = f.KeyValue.FieldDelimiter
+		}
+		parse.Function = fmt.Sprintf("parse_key_value(value, key_value_delimiter: %s, field_delimiter: %s)", helpers.VRLString(keyValueDelimiter), helpers.VRLString(fieldDelimiter))
+	case obs.ParseFormatCSV:
+		if f.CSV == nil || len(f.CSV.Headers) == 0 {
+			return "", fmt.Errorf("csv format requires headers")
+		}
+		headers := []string{}
+		for _, h := range f.CSV.Headers {
+			headers = append(headers, helpers.VRLString(h))
+		}
+		parse.Headers = fmt.Sprintf("[%s]", strings.Join(headers, ", "))
+		parse.Delimiter = helpers.VRLString(DefaultCSVDelimiter)
+		if f.CSV.Delimiter != "" {
+			parse.Delimiter = helpers.VRLString(f.CSV.Delimiter)
+		}
+	case obs.ParseFormatRegex:
+		if f.Regex == nil || f.Regex.Pattern == "" {
+			return "", fmt.Errorf("regex format requires a pattern")
+		}
+		if strings.ContainsAny(f.Regex.Pattern, "'\n\r") {
+			return "", fmt.Errorf("regex pattern must not contain single quotes, newlines, or carriage returns: %q", f.Regex.Pattern)
+		}
+		parse.Function = fmt.Sprintf("parse_regex(value, r'%s')", f.Regex.Pattern)
+	default:
+		function, found := functions[format]
+		if !found {
+			return "", fmt.Errorf("unknown parse format: %q", format)
+		}
+		parse.Function = function
+	}
+
+	// Execute Go template to generate VRL
+	w := &strings.Builder{}
+	err := ParseVRLTemplate.Execute(w, parse)
+	return w.String(), err
+}
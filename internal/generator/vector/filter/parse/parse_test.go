@@ -0,0 +1,87 @@
+package parse
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("parse filter", func() {
+
+	DescribeTable("#NewFormat", func(spec obs.ParseFilterSpec, expFile string) {
+		exp, err := tomlContent.ReadFile(expFile)
+		if err != nil {
+			Fail(fmt.Sprintf("Error reading the file %q with exp config: %v", expFile, err))
+		}
+		Expect(exp).To(EqualConfigFrom(api.NewConfig(func(c *api.Config) {
+			c.Transforms["my_parse"] = NewFormat(&spec, "application")
+		})))
+	},
+		Entry("with json", obs.ParseFilterSpec{}, "parse_json.toml"),
+		Entry("with logfmt", obs.ParseFilterSpec{
+			Format:    obs.ParseFormatLogfmt,
+			OnFailure: obs.ParseFailurePolicyTag,
+		}, "parse_logfmt.toml"),
+		Entry("with keyValue", obs.ParseFilterSpec{
+			Format: obs.ParseFormatKeyValue,
+			Source: ".payload",
+			Target: ".fields",
+			KeyValue: &obs.ParseKeyValueSpec{
+				KeyValueDelimiter: ":",
+				FieldDelimiter:    ";",
+			},
+		}, "parse_key_value.toml"),
+		Entry("with csv", obs.ParseFilterSpec{
+			Format:    obs.ParseFormatCSV,
+			OnFailure: obs.ParseFailurePolicyDrop,
+			CSV: &obs.ParseCSVSpec{
+				Headers:   []string{"time", "level", "msg"},
+				Delimiter: "|",
+			},
+		}, "parse_csv.toml"),
+		Entry("with csv headers and delimiter that need escaping", obs.ParseFilterSpec{
+			Format: obs.ParseFormatCSV,
+			CSV: &obs.ParseCSVSpec{
+				Headers:   []string{"cost$", `say "hi"`, `C:\path`},
+				Delimiter: "\t",
+			},
+		}, "parse_csv_escaped.toml"),
+		Entry("with regex", obs.ParseFilterSpec{
+			Format: obs.ParseFormatRegex,
+			Regex: &obs.ParseRegexSpec{
+				Pattern: `^(?P<level>\w+) (?P<msg>.*)$`,
+			},
+		}, "parse_regex.toml"),
+		Entry("with syslog", obs.ParseFilterSpec{
+			Format: obs.ParseFormatSyslog,
+			Target: ".syslog",
+		}, "parse_syslog.toml"),
+		Entry("with cef", obs.ParseFilterSpec{
+			Format:    obs.ParseFormatCEF,
+			Target:    ".cef",
+			OnFailure: obs.ParseFailurePolicyTag,
+		}, "parse_cef.toml"),
+		Entry("with commonLog", obs.ParseFilterSpec{
+			Format:    obs.ParseFormatCommonLog,
+			Target:    ".access",
+			OnFailure: obs.ParseFailurePolicyDrop,
+		}, "parse_common_log.toml"),
+	)
+
+	It("should fail for a regex pattern with a single quote", func() {
+		_, err := NewFormatFilter(&obs.ParseFilterSpec{
+			Format: obs.ParseFormatRegex,
+			Regex:  &obs.ParseRegexSpec{Pattern: `^(?P<msg>'.*)$`},
+		}).VRL()
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should fail for the csv format without headers", func() {
+		_, err := NewFormatFilter(&obs.ParseFilterSpec{Format: obs.ParseFormatCSV}).VRL()
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,19 @@
+package parse
+
+import (
+	"embed"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var (
+	//go:embed *.toml
+	tomlContent embed.FS
+)
+
+func TestParseFunctions(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "[filter][parse] Unit Tests")
+}
@@ -0,0 +1,125 @@
+package redact
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+const (
+	DefaultMask    = "[REDACTED]"
+	DefaultVisible = 4
+)
+
+type Redact struct {
+	Fields   []string
+	Patterns []string
+	Strategy obs.RedactStrategy
+	Mask     string
+	Visible  int
+}
+
+var (
+	RedactVRLTemplate = template.Must(template.New("redact VRL").Parse(redactVRLTemplateStr))
+
+	//go:embed redact.vrl.tmpl
+	redactVRLTemplateStr string
+
+	// Match `\Q` or `\E` escapes that are not themselves escaped
+	unsupportedEscape = regexp.MustCompile(`(^|[^\\])(\\\\)*\\[QE]`)
+
+	// Patterns are the regular expressions of the built-in patterns
+	Patterns = map[obs.RedactPatternType]string{
+		obs.RedactPatternBearerToken: `(?i)\bbearer\s+[A-Za-z0-9\-._~+/]+=*`,
+		obs.RedactPatternCreditCard:  `\b(?:\d[ -]?){12,18}\d\b`,
+		obs.RedactPatternEmail:       `[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)*\.[A-Za-z]{2,}`,
+	}
+)
+
+type RedactFilter obs.RedactFilterSpec
+
+func NewFilter(redactFilterSpec *obs.RedactFilterSpec) RedactFilter {
+	return RedactFilter(*redactFilterSpec)
+}
+
+func New(spec *obs.RedactFilterSpec, inputs ...string) types.Transform {
+	rf := NewFilter(spec)
+	vrl, err := rf.VRL()
+	if err != nil {
+		log.Error(err, "bad filter", "redactFilterSpec", spec)
+		return nil
+	}
+	return transforms.NewRemap(vrl, inputs...)
+}
+
+func (f RedactFilter) VRL() (string, error) {
+	redact := Redact{
+		Strategy: obs.RedactStrategyMask,
+		Mask:     DefaultMask,
+		Visible:  DefaultVisible,
+	}
+	// The internal copy of a field is redacted as well because outputs, routes and key templates read it
+	for _, field := range f.Fields {
+		redact.Fields = append(redact.Fields, string(field), "._internal"+string(field))
+	}
+	for _, p := range f.Patterns {
+		pattern, found := Patterns[p]
+		if !found {
+			return "", fmt.Errorf("unknown redact pattern: %q", p)
+		}
+		redact.Patterns = append(redact.Patterns, pattern)
+	}
+	for _, r := range f.Regexes {
+		if err := ValidateRegex(r); err != nil {
+			return "", err
+		}
+		// Vector interpolates `$` in the config, so a literal `$` must be escaped as `$$`
+		redact.Patterns = append(redact.Patterns, strings.ReplaceAll(r, "$", "$$"))
+	}
+	if len(redact.Fields) == 0 || len(redact.Patterns) == 0 {
+		return "", errors.New("redact filter requires at least one field and one of patterns or regexes")
+	}
+	if f.Replacement != nil {
+		if f.Replacement.Strategy != "" {
+			redact.Strategy = f.Replacement.Strategy
+		}
+		if f.Replacement.Mask != "" {
+			redact.Mask = f.Replacement.Mask
+		}
+		if f.Replacement.Visible != nil {
+			redact.Visible = *f.Replacement.Visible
+		}
+	}
+	// The replacement of `replace` expands capture groups so a literal `$` must be escaped as `$$`,
+	// which VRLString doubles again for the interpolation of the config by Vector
+	redact.Mask = helpers.VRLString(strings.ReplaceAll(redact.Mask, "$", "$$"))
+
+	// Execute Go template to generate VRL
+	w := &strings.Builder{}
+	err := RedactVRLTemplate.Execute(w, redact)
+	return w.String(), err
+}
+
+// ValidateRegex verifies a regular expression can be embedded in VRL as a regex literal and compiled by the collector.
+// The collector uses the Rust regex syntax which, unlike Go, does not support `\Q...\E` literal quoting
+func ValidateRegex(expr string) error {
+	if strings.ContainsAny(expr, "'\n\r") {
+		return fmt.Errorf("regex must not contain single quotes, newlines, or carriage returns: %q", expr)
+	}
+	if _, err := regexp.Compile(expr); err != nil {
+		return fmt.Errorf("invalid regular expression %q: %v", expr, err)
+	}
+	if unsupportedEscape.MatchString(expr) {
+		return fmt.Errorf("invalid regular expression %q: \\Q...\\E quoting is not supported", expr)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package redact
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	"github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("redact filter", func() {
+	Context("#VRL", func() {
+		It("should generate VRL masking matches of the fields and their internal copies with the default mask", func() {
+			spec := &obs.RedactFilterSpec{
+				Fields:   []obs.FieldPath{".message", `.kubernetes.annotations."example.com/token"`},
+				Patterns: []obs.RedactPatternType{obs.RedactPatternEmail},
+				Regexes:  []string{`secret=\w+`},
+			}
+			Expect(NewFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+if is_string(.message) {
+  redacted = string!(.message)
+  redacted = replace(redacted, r'[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)*\.[A-Za-z]{2,}', "[REDACTED]")
+  redacted = replace(redacted, r'secret=\w+', "[REDACTED]")
+  .message = redacted
+}
+if is_string(._internal.message) {
+  redacted = string!(._internal.message)
+  redacted = replace(redacted, r'[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)*\.[A-Za-z]{2,}', "[REDACTED]")
+  redacted = replace(redacted, r'secret=\w+', "[REDACTED]")
+  ._internal.message = redacted
+}
+if is_string(.kubernetes.annotations."example.com/token") {
+  redacted = string!(.kubernetes.annotations."example.com/token")
+  redacted = replace(redacted, r'[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)*\.[A-Za-z]{2,}', "[REDACTED]")
+  redacted = replace(redacted, r'secret=\w+', "[REDACTED]")
+  .kubernetes.annotations."example.com/token" = redacted
+}
+if is_string(._internal.kubernetes.annotations."example.com/token") {
+  redacted = string!(._internal.kubernetes.annotations."example.com/token")
+  redacted = replace(redacted, r'[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)*\.[A-Za-z]{2,}', "[REDACTED]")
+  redacted = replace(redacted, r'secret=\w+', "[REDACTED]")
+  ._internal.kubernetes.annotations."example.com/token" = redacted
+}
+`))
+		})
+
+		It("should escape a custom mask", func() {
+			spec := &obs.RedactFilterSpec{
+				Fields:      []obs.FieldPath{".message"},
+				Regexes:     []string{`\d+`},
+				Replacement: &obs.RedactReplacement{Mask: `"$1"`},
+			}
+			Expect(NewFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+if is_string(.message) {
+  redacted = string!(.message)
+  redacted = replace(redacted, r'\d+', "\"$$$$1\"")
+  .message = redacted
+}
+if is_string(._internal.message) {
+  redacted = string!(._internal.message)
+  redacted = replace(redacted, r'\d+', "\"$$$$1\"")
+  ._internal.message = redacted
+}
+`))
+		})
+
+		It("should escape the interpolation of a custom regex", func() {
+			spec := &obs.RedactFilterSpec{
+				Fields:  []obs.FieldPath{".message"},
+				Regexes: []string{`token=\w+$`},
+			}
+			Expect(NewFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+if is_string(.message) {
+  redacted = string!(.message)
+  redacted = replace(redacted, r'token=\w+$$', "[REDACTED]")
+  .message = redacted
+}
+if is_string(._internal.message) {
+  redacted = string!(._internal.message)
+  redacted = replace(redacted, r'token=\w+$$', "[REDACTED]")
+  ._internal.message = redacted
+}
+`))
+		})
+
+		It("should generate VRL hashing matches", func() {
+			spec := &obs.RedactFilterSpec{
+				Fields:      []obs.FieldPath{".message"},
+				Patterns:    []obs.RedactPatternType{obs.RedactPatternBearerToken},
+				Replacement: &obs.RedactReplacement{Strategy: obs.RedactStrategyHash},
+			}
+			Expect(NewFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+if is_string(.message) {
+  redacted = string!(.message)
+  redacted = replace_with(redacted, r'(?i)\bbearer\s+[A-Za-z0-9\-._~+/]+=*') -> |match| {
+    sha2(match.string, variant: "SHA-256")
+  }
+  .message = redacted
+}
+if is_string(._internal.message) {
+  redacted = string!(._internal.message)
+  redacted = replace_with(redacted, r'(?i)\bbearer\s+[A-Za-z0-9\-._~+/]+=*') -> |match| {
+    sha2(match.string, variant: "SHA-256")
+  }
+  ._internal.message = redacted
+}
+`))
+		})
+
+		It("should generate VRL partially masking matches", func() {
+			spec := &obs.RedactFilterSpec{
+				Fields:      []obs.FieldPath{".message"},
+				Patterns:    []obs.RedactPatternType{obs.RedactPatternCreditCard},
+				Replacement: &obs.RedactReplacement{Strategy: obs.RedactStrategyPartial, Visible: utils.GetPtr(2)},
+			}
+			Expect(NewFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+if is_string(.message) {
+  redacted = string!(.message)
+  redacted = replace_with(redacted, r'\b(?:\d[ -]?){12,18}\d\b') -> |match| {
+    n = strlen(match.string)
+    if n > 2 {
+      replace(slice!(match.string, 0, n - 2), r'(?s).', "*") + slice!(match.string, n - 2)
+    } else {
+      replace(match.string, r'(?s).', "*")
+    }
+  }
+  .message = redacted
+}
+if is_string(._internal.message) {
+  redacted = string!(._internal.message)
+  redacted = replace_with(redacted, r'\b(?:\d[ -]?){12,18}\d\b') -> |match| {
+    n = strlen(match.string)
+    if n > 2 {
+      replace(slice!(match.string, 0, n - 2), r'(?s).', "*") + slice!(match.string, n - 2)
+    } else {
+      replace(match.string, r'(?s).', "*")
+    }
+  }
+  ._internal.message = redacted
+}
+`))
+		})
+
+		It("should fail without patterns or regexes", func() {
+			spec := &obs.RedactFilterSpec{
+				Fields: []obs.FieldPath{".message"},
+			}
+			_, err := NewFilter(spec).VRL()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject regexes containing single quotes", func() {
+			spec := &obs.RedactFilterSpec{
+				Fields:  []obs.FieldPath{".message"},
+				Regexes: []string{`foo'bar`},
+			}
+			_, err := NewFilter(spec).VRL()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	DescribeTable("#ValidateRegex", func(expr string, valid bool) {
+		if valid {
+			Expect(ValidateRegex(expr)).To(Succeed())
+		} else {
+			Expect(ValidateRegex(expr)).ToNot(Succeed())
+		}
+	},
+		Entry("accepts a valid expression", `token=[a-z0-9]+`, true),
+		Entry("accepts an escaped backslash followed by Q", `\\Q`, true),
+		Entry("rejects single quotes", `foo'bar`, false),
+		Entry("rejects invalid expressions", `foo(bar`, false),
+		Entry("rejects literal quoting", `\Qa.b\E`, false),
+	)
+})
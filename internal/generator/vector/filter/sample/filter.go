@@ -0,0 +1,31 @@
+package sample
+
+import (
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/drop"
+)
+
+// New returns a sample transform keeping the configured fraction of records
+func New(spec *obs.SampleFilterSpec, inputs ...string) types.Transform {
+	exclude, err := drop.NewFilter(spec.Exclude).Condition()
+	if err != nil {
+		log.Error(err, "bad filter", "sampleFilterSpec", spec)
+		return nil
+	}
+	return transforms.NewSample(func(s *transforms.Sample) {
+		if spec.Rate != nil {
+			s.Rate = uint64(*spec.Rate)
+		}
+		if spec.Percent != nil {
+			s.Ratio = float64(*spec.Percent) / 100
+		}
+		if spec.KeyField != "" {
+			// The sample transform expects an event path without the leading `.`
+			s.KeyField = "_internal" + string(spec.KeyField)
+		}
+		s.Exclude = transforms.Condition(exclude)
+	}, inputs...)
+}
@@ -0,0 +1,50 @@
+package sample
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+)
+
+var _ = Describe("sample filter", func() {
+	It("should keep 1 out of rate records", func() {
+		spec := &obs.SampleFilterSpec{
+			Rate: utils.GetPtr[int64](10),
+		}
+		Expect(New(spec, "b", "a")).To(Equal(&transforms.Sample{
+			Type:   types.TransformTypeSample,
+			Inputs: []string{"a", "b"},
+			Rate:   10,
+		}))
+	})
+
+	It("should keep a percent of records consistently per key with exclusions", func() {
+		spec := &obs.SampleFilterSpec{
+			Percent:  utils.GetPtr[int32](25),
+			KeyField: `.kubernetes.labels."app.kubernetes.io/name"`,
+			Exclude: []obs.DropTest{
+				{
+					DropConditions: []obs.DropCondition{
+						{Field: ".level", Matches: "error|critical"},
+					},
+				},
+				{
+					DropConditions: []obs.DropCondition{
+						{Field: ".kubernetes.namespace_name", Matches: "^my-app$"},
+						{Field: ".message", NotMatches: "debug"},
+					},
+				},
+			},
+		}
+		Expect(New(spec, "a")).To(Equal(&transforms.Sample{
+			Type:     types.TransformTypeSample,
+			Inputs:   []string{"a"},
+			Ratio:    0.25,
+			KeyField: `_internal.kubernetes.labels."app.kubernetes.io/name"`,
+			Exclude:  `(match(to_string(._internal.level) ?? "", r'error|critical')) || (match(to_string(._internal.kubernetes.namespace_name) ?? "", r'^my-app$') && !match(to_string(._internal.message) ?? "", r'debug'))`,
+		}))
+	})
+})
@@ -0,0 +1,13 @@
+package sample
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSampleFilter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "[filter][sample] Unit Tests")
+}
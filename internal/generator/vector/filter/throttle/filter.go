@@ -0,0 +1,37 @@
+package throttle
+
+import (
+	"fmt"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	commontemplate "github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/template"
+)
+
+const (
+	defaultWindowSecs = 1
+)
+
+// New returns a throttle transform limiting records for each value of the key
+func New(spec *obs.ThrottleFilterSpec, inputs ...string) types.Transform {
+	return transforms.NewThrottle(func(t *transforms.Throttle) {
+		t.WindowSecs = defaultWindowSecs
+		if spec.WindowSeconds > 0 {
+			t.WindowSecs = uint64(spec.WindowSeconds)
+		}
+		t.Threshold = uint64(spec.Threshold)
+		t.KeyField = KeyTemplate(spec.Key)
+		t.InternalMetrics = &transforms.ThrottleInternalMetrics{
+			EmitEventsDiscardedPerKey: true,
+		}
+	}, inputs...)
+}
+
+// KeyTemplate converts the user entered key to a collector template
+// Example: {.kubernetes.namespace_name}-foo -> {{ _internal.kubernetes.namespace_name }}-foo
+func KeyTemplate(key string) string {
+	return commontemplate.PathRegex.ReplaceAllStringFunc(key, func(match string) string {
+		return fmt.Sprintf("{{ _internal%s }}", commontemplate.PathRegex.FindStringSubmatch(match)[1])
+	})
+}
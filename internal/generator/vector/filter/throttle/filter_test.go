@@ -0,0 +1,46 @@
+package throttle
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+)
+
+var _ = Describe("throttle filter", func() {
+	DescribeTable("#KeyTemplate", func(key, exp string) {
+		Expect(KeyTemplate(key)).To(Equal(exp))
+	},
+		Entry("with a single field", "{.kubernetes.namespace_name}", "{{ _internal.kubernetes.namespace_name }}"),
+		Entry("with static values and quoted segments", `ns-{.kubernetes.namespace_name}/{.kubernetes.labels."app.kubernetes.io/name"}`,
+			`ns-{{ _internal.kubernetes.namespace_name }}/{{ _internal.kubernetes.labels."app.kubernetes.io/name" }}`),
+		Entry("with only static values", "all", "all"),
+	)
+
+	It("should generate a throttle with per key discard metrics", func() {
+		spec := &obs.ThrottleFilterSpec{
+			Key:       "{.kubernetes.namespace_name}",
+			Threshold: 100,
+		}
+		Expect(New(spec, "a")).To(Equal(&transforms.Throttle{
+			Type:       types.TransformTypeThrottle,
+			Inputs:     []string{"a"},
+			WindowSecs: 1,
+			Threshold:  100,
+			KeyField:   "{{ _internal.kubernetes.namespace_name }}",
+			InternalMetrics: &transforms.ThrottleInternalMetrics{
+				EmitEventsDiscardedPerKey: true,
+			},
+		}))
+	})
+
+	It("should use the configured window", func() {
+		spec := &obs.ThrottleFilterSpec{
+			Key:           "{.kubernetes.pod_name}",
+			Threshold:     10,
+			WindowSeconds: 60,
+		}
+		Expect(New(spec, "a").(*transforms.Throttle).WindowSecs).To(BeEquivalentTo(60))
+	})
+})
@@ -0,0 +1,31 @@
+package transform
+
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sinks"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sources"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+	"github.com/openshift/cluster-logging-operator/internal/utils/toml"
+)
+
+const (
+	checkSourceID    = "input"
+	checkTransformID = "transform"
+	checkSinkID      = "output"
+)
+
+// CheckConfig returns a collector configuration that only loads the program of a transform filter with the
+// remap transform the forwarder configuration uses, for `vector validate` to compile it
+func CheckConfig(spec *obs.TransformFilterSpec) (string, error) {
+	vrl, err := NewFilter(spec).VRL()
+	if err != nil {
+		return "", err
+	}
+	config := api.NewConfig(func(c *api.Config) {
+		c.Sources[checkSourceID] = sources.NewDemoLogs(sources.DemoLogsFormatJson)
+		c.Transforms[checkTransformID] = transforms.NewRemap(vrl, checkSourceID)
+		c.Sinks[checkSinkID] = sinks.NewBlackhole(checkTransformID)
+	})
+	return toml.Marshal(config)
+}
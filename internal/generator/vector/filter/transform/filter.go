@@ -0,0 +1,41 @@
+package transform
+
+import (
+	"errors"
+	"strings"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+)
+
+type Filter struct {
+	*obs.TransformFilterSpec
+}
+
+// NewFilter returns a transform filter
+func NewFilter(spec *obs.TransformFilterSpec) Filter {
+	return Filter{spec}
+}
+
+func New(spec *obs.TransformFilterSpec, inputs ...string) types.Transform {
+	vrl, err := NewFilter(spec).VRL()
+	if err != nil {
+		log.Error(err, "bad filter", "transform", spec)
+		return nil
+	}
+	return transforms.NewRemap(vrl, inputs...)
+}
+
+// VRL returns the user supplied program once it passes the syntax check. Dollar signs are doubled
+// so Vector does not interpolate them as environment variables
+func (f Filter) VRL() (string, error) {
+	if f.TransformFilterSpec == nil || strings.TrimSpace(f.Source) == "" {
+		return "", errors.New("transform filter requires a VRL source")
+	}
+	if err := CheckSyntax(f.Source); err != nil {
+		return "", err
+	}
+	return strings.ReplaceAll(f.Source, "$", "$$"), nil
+}
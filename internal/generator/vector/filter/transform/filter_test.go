@@ -0,0 +1,86 @@
+package transform
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+	"github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("transform filter", func() {
+
+	Context("#VRL", func() {
+		It("should return the user supplied program", func() {
+			spec := &obs.TransformFilterSpec{
+				Source: `
+.app = del(.kubernetes.labels.app)
+if exists(.level) {
+  .level = upcase!(.level)
+}
+`,
+			}
+			Expect(NewFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+.app = del(.kubernetes.labels.app)
+if exists(.level) {
+  .level = upcase!(.level)
+}
+`))
+		})
+
+		It("should escape the interpolation of environment variables", func() {
+			spec := &obs.TransformFilterSpec{
+				Source: `.id = replace(string!(.id), r'(\d+)', "$1") + "${RECEIVER_TOKEN_A}"`,
+			}
+			Expect(NewFilter(spec).VRL()).To(Equal(`.id = replace(string!(.id), r'(\d+)', "$$1") + "$${RECEIVER_TOKEN_A}"`))
+		})
+
+		It("should fail when the program has a syntax error", func() {
+			_, err := NewFilter(&obs.TransformFilterSpec{Source: `.foo = "bar`}).VRL()
+			Expect(err).To(MatchError(ContainSubstring("unterminated string literal")))
+		})
+
+		It("should fail when the spec is missing", func() {
+			_, err := NewFilter(nil).VRL()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("#New", func() {
+		It("should generate a remap transform", func() {
+			tf := New(&obs.TransformFilterSpec{Source: `.foo = "bar"`}, "pipeline_a", "input_b")
+			Expect(tf).To(Equal(transforms.NewRemap(`.foo = "bar"`, "input_b", "pipeline_a")))
+		})
+		It("should not generate a transform for a program with a syntax error", func() {
+			Expect(New(&obs.TransformFilterSpec{Source: `.foo = (`}, "input")).To(BeNil())
+		})
+	})
+
+	Context("#CheckConfig", func() {
+		It("should generate a configuration that only loads the program", func() {
+			Expect(CheckConfig(&obs.TransformFilterSpec{Source: `.foo = "bar"`})).To(matchers.EqualTrimLines(`
+[sources]
+[sources.input]
+type = "demo_logs"
+format = "json"
+
+[transforms]
+[transforms.transform]
+type = "remap"
+inputs = ["input"]
+source = '''
+.foo = "bar"
+'''
+
+[sinks]
+[sinks.output]
+type = "blackhole"
+inputs = ["transform"]
+`))
+		})
+		It("should fail for a program with a syntax error", func() {
+			_, err := CheckConfig(&obs.TransformFilterSpec{Source: `.foo = (`})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,154 @@
+package transform
+
+import (
+	"fmt"
+	"strings"
+)
+
+var closers = map[rune]rune{
+	')': '(',
+	']': '[',
+	'}': '{',
+}
+
+// SyntaxError describes the location and cause of a syntax error in a VRL program
+type SyntaxError struct {
+	Line   int
+	Column int
+	Reason string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at line %d, column %d: %s", e.Line, e.Column, e.Reason)
+}
+
+type position struct {
+	line   int
+	column int
+}
+
+func (p position) errorf(format string, args ...interface{}) *SyntaxError {
+	return &SyntaxError{Line: p.line, Column: p.column, Reason: fmt.Sprintf(format, args...)}
+}
+
+// CheckSyntax is a lexical check of a VRL program, it does not compile the program. It reports
+// unterminated string, raw string, regex and timestamp literals, unbalanced delimiters and
+// characters that can not start a token.
+//
+// A program passing the check can still be rejected by the VRL compiler of the collector, e.g. for
+// an unknown function, a wrong argument type, an unhandled fallible call or a regex literal that is
+// not a valid regular expression of the collector's regex engine, which the operator
+// checks by compiling the configuration of CheckConfig with the collector. The program must also
+// be safe to embed in the generated configuration as a literal string
+func CheckSyntax(source string) error {
+	if strings.Contains(source, "'''") {
+		return &SyntaxError{Line: 1, Column: 1, Reason: "program must not contain three consecutive single quotes"}
+	}
+	var (
+		runes  = []rune(source)
+		opened []rune
+		starts []position
+		pos    = position{line: 1, column: 1}
+		empty  = true
+	)
+	advance := func(r rune) {
+		if r == '\n' {
+			pos.line++
+			pos.column = 1
+		} else {
+			pos.column++
+		}
+	}
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '#':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+			if i < len(runes) {
+				advance(runes[i])
+			}
+			continue
+		case r == '"':
+			start := pos
+			end, ok := scanQuoted(runes, i, '"')
+			if !ok {
+				return start.errorf("unterminated string literal")
+			}
+			for ; i < end; i++ {
+				advance(runes[i])
+			}
+			advance(runes[i])
+			empty = false
+			continue
+		case r == '\'' && i > 0 && strings.ContainsRune("rst", runes[i-1]) && (i < 2 || !isIdentRune(runes[i-2])):
+			start := position{line: pos.line, column: pos.column - 1}
+			end, ok := scanQuoted(runes, i, '\'')
+			if !ok {
+				return start.errorf("unterminated %s literal", literalKind(runes[i-1]))
+			}
+			for ; i < end; i++ {
+				advance(runes[i])
+			}
+			advance(runes[i])
+			continue
+		case r == '\'':
+			return pos.errorf("unexpected character %q", r)
+		case r == '(' || r == '[' || r == '{':
+			opened = append(opened, r)
+			starts = append(starts, pos)
+		case r == ')' || r == ']' || r == '}':
+			if len(opened) == 0 || opened[len(opened)-1] != closers[r] {
+				return pos.errorf("unexpected closing %q", r)
+			}
+			opened = opened[:len(opened)-1]
+			starts = starts[:len(starts)-1]
+		case r == '`' || r == '$' || r == '\\':
+			return pos.errorf("unexpected character %q", r)
+		}
+		if !isSpace(r) {
+			empty = false
+		}
+		advance(r)
+	}
+	if len(opened) > 0 {
+		return starts[len(starts)-1].errorf("unclosed %q", opened[len(opened)-1])
+	}
+	if empty {
+		return pos.errorf("program is empty")
+	}
+	return nil
+}
+
+// scanQuoted returns the index of the unescaped quote that closes the literal opened at index start
+func scanQuoted(runes []rune, start int, quote rune) (int, bool) {
+	for i := start + 1; i < len(runes); i++ {
+		switch runes[i] {
+		case '\\':
+			i++
+		case quote:
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+func literalKind(prefix rune) string {
+	switch prefix {
+	case 'r':
+		return "regex"
+	case 't':
+		return "timestamp"
+	default:
+		return "raw string"
+	}
+}
+
+func isIdentRune(r rune) bool {
+	return r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9'
+}
+
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\n' || r == '\r'
+}
@@ -0,0 +1,39 @@
+package transform
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("#CheckSyntax", func() {
+
+	DescribeTable("valid programs", func(source string) {
+		Expect(CheckSyntax(source)).To(Succeed())
+	},
+		Entry("with assignments and function calls", `.foo = "bar"
+.count = to_int!(.count) + 1`),
+		Entry("with nested blocks", `if .level == "debug" { abort } else { .tags = ["a", "b"] }`),
+		Entry("with comments containing delimiters", `# drop the ( field
+del(.foo)`),
+		Entry("with quoted path segments", `.kubernetes.labels."app.kubernetes.io/name" = "foo"`),
+		Entry("with escaped quotes in strings", `.message = "say \"hi\" {"`),
+		Entry("with raw strings and regexes", `.msg = replace(string!(.message), r'\d+', s'#') ?? .message
+.ts = t'2021-02-11T10:32:50.553955473Z'`),
+		Entry("with an identifier ending in r before a string", `.for = "bar"`),
+		Entry("with a regex that is only compiled by the collector", `.foo = match!(.message, r'(abc')`),
+	)
+
+	DescribeTable("invalid programs", func(source, message string) {
+		err := CheckSyntax(source)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal(message))
+	},
+		Entry("with an empty program", "  \n# only a comment\n", "syntax error at line 3, column 1: program is empty"),
+		Entry("with an unterminated string", `.foo = "bar`, "syntax error at line 1, column 8: unterminated string literal"),
+		Entry("with an unterminated regex", `.foo = match(.message, r'abc)`, "syntax error at line 1, column 24: unterminated regex literal"),
+		Entry("with an unclosed block", "if true {\n  .foo = 1\n", "syntax error at line 1, column 9: unclosed '{'"),
+		Entry("with a mismatched delimiter", ".foo = [1, 2)", "syntax error at line 1, column 13: unexpected closing ')'"),
+		Entry("with a stray single quote", ".foo = 'bar'", "syntax error at line 1, column 8: unexpected character '\\''"),
+		Entry("with a triple single quote", ".foo = s'''", "syntax error at line 1, column 1: program must not contain three consecutive single quotes"),
+	)
+})
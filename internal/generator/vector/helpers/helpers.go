@@ -86,6 +86,41 @@ func SecretFrom(secretKey *v1.SecretReference) string {
 	return ""
 }
 
+// VRLString returns the value as a double quoted VRL string literal. Unlike the Go quoting of %q, only the escape
+// sequences of VRL are used. Single quotes are escaped so the literal can not end the TOML literal string holding the
+// program, and dollar signs are doubled so Vector does not interpolate them as environment variables
+// E.g. say "hi" $USER -> "say \"hi\" $$USER"
+func VRLString(value string) string {
+	b := &strings.Builder{}
+	b.WriteByte('"')
+	for _, r := range value {
+		switch r {
+		case '\\':
+			b.WriteString(`\\`)
+		case '"':
+			b.WriteString(`\"`)
+		case '\'':
+			b.WriteString(`\'`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '$':
+			b.WriteString("$$")
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(b, `\u{%x}`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
 // GenerateQuotedPathSegmentArrayStr generates the final string of the array of array of path segments
 // and array of flattened path with replaced not allowed symbols to feed into VRL
 // E.g
@@ -7,6 +7,18 @@ import (
 )
 
 var _ = Describe("helpers functions", func() {
+	DescribeTable("#VRLString", func(value, exp string) {
+		Expect(VRLString(value)).To(Equal(exp))
+	},
+		Entry("should quote a plain value", "[REDACTED]", `"[REDACTED]"`),
+		Entry("should escape double quotes and backslashes", `say "hi" \o/`, `"say \"hi\" \\o/"`),
+		Entry("should escape single quotes", "'''", `"\'\'\'"`),
+		Entry("should escape the newline, carriage return and tab characters", "a\nb\rc\td", `"a\nb\rc\td"`),
+		Entry("should escape other control characters as unicode", "a\x00b\x1bc", `"a\u{0}b\u{1b}c"`),
+		Entry("should double dollar signs", "$HOME ${USER}", `"$$HOME $${USER}"`),
+		Entry("should not escape unicode characters", "héllo ✓", `"héllo ✓"`),
+	)
+
 	Context("#GenerateQuotedPathSegmentArrayStr", func() {
 		It("should generate array of path segments and flat path for single value", func() {
 			pathExpression := []obs.FieldPath{`.kubernetes.labels.foo`}
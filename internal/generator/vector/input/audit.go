@@ -1,10 +1,14 @@
 package input
 
 import (
+	"fmt"
+	"strings"
+
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
 	sourcesfile "github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sources"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
 	v1 "github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/openshift/viaq/v1"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
@@ -37,9 +41,21 @@ func NewAuditAuditdSource(input *adapters.Input) (id string, _ types.Source, tfs
 	f.MaxReadBytes = MaxReadBytes
 	f.RotateWaitSecs = RotateWaitSecs
 	tfs.Add(metaID, NewInternalNormalization(obs.AuditSourceAuditd, obs.InputTypeAudit, id, v1.ParseHostAuditLogs))
+	if recordTypes := auditdRecordTypes(input); len(recordTypes) > 0 {
+		typesID := helpers.MakeID(id, "types")
+		tfs.Add(typesID, transforms.NewFilter(fmt.Sprintf(`includes(%s, ._internal."audit.linux".type)`, vrlStringArray(recordTypes)), metaID))
+		metaID = typesID
+	}
 	input.Ids = append(input.Ids, metaID)
 	return id, f, tfs
+}
 
+// auditdRecordTypes returns the types of the auditd records to collect or nil to collect all records
+func auditdRecordTypes(input *adapters.Input) []string {
+	if input.Audit != nil && input.Audit.Tuning != nil {
+		return input.Audit.Tuning.AuditdRecordTypes
+	}
+	return nil
 }
 
 func NewK8sAuditSource(input *adapters.Input) (id string, _ types.Source, tfs api.Transforms) {
@@ -85,7 +101,16 @@ func NewOVNAuditSource(input *adapters.Input) (id string, _ types.Source, tfs ap
 	f.MaxLineBytes = MaxLineBytes
 	f.MaxReadBytes = MaxReadBytes
 	f.RotateWaitSecs = RotateWaitSecs
-	tfs.Add(metaID, NewInternalNormalization(obs.AuditSourceOVN, obs.InputTypeAudit, id))
+	tfs.Add(metaID, NewInternalNormalization(obs.AuditSourceOVN, obs.InputTypeAudit, id, v1.ParseOVNAuditLogs))
 	input.Ids = append(input.Ids, metaID)
 	return id, f, tfs
 }
+
+// vrlStringArray formats the values as a VRL array of strings
+func vrlStringArray(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = helpers.VRLString(v)
+	}
+	return fmt.Sprintf("[%s]", strings.Join(quoted, ", "))
+}
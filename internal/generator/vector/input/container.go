@@ -44,10 +44,11 @@ var (
 func NewContainerSource(spec *adapters.Input, includes, excludes []string, logType obs.InputType, logSource interface{}) (id string, source types.Source, tfs api.Transforms) {
 	tfs = api.Transforms{}
 	base := helpers.MakeInputID(spec.Name, "container")
-	var selector *metav1.LabelSelector
+	var selector, namespaceSelector *metav1.LabelSelector
 	maxMsgSize := int64(0)
 	if spec.Application != nil {
 		selector = spec.Application.Selector
+		namespaceSelector = spec.Application.NamespaceSelector
 		if spec.Application.Tuning != nil && spec.Application.Tuning.MaxMessageSize != nil {
 			if size, ok := spec.Application.Tuning.MaxMessageSize.AsInt64(); ok {
 				maxMsgSize = size
@@ -72,12 +73,14 @@ func NewContainerSource(spec *adapters.Input, includes, excludes []string, logTy
 		kl.IncludePathsGlobPatterns = includes
 		kl.ExcludePathsGlobPatterns = excludes
 		kl.ExtraLabelSelector = helpers2.LabelSelectorFrom(selector)
+		kl.ExtraNamespaceLabelSelector = helpers2.LabelSelectorFrom(namespaceSelector)
 		kl.PodAnnotationFields = &sources.PodAnnotationFields{
 			PodLabels:      "kubernetes.labels",
 			PodNamespace:   "kubernetes.namespace_name",
 			PodAnnotations: "kubernetes.annotations",
 			PodUid:         "kubernetes.pod_id",
 			PodNodeName:    "hostname",
+			PodOwner:       "kubernetes.pod_owner",
 		}
 		kl.NamespaceAnnotationFields = &sources.NamespaceAnnotationFields{
 			NamespaceUid: "kubernetes.namespace_id",
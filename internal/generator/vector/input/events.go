@@ -0,0 +1,45 @@
+package input
+
+import (
+	"fmt"
+
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sources"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/codec"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+const (
+	// EventsSourceID is the id of the source receiving the events from the events watcher. It is shared by
+	// all events inputs and does not collide with the ids of inputs which are prefixed with 'input_'
+	EventsSourceID = "kubernetes_events"
+
+	// eventsSourceAddress is the loopback address the events watcher running in the collector pod posts to
+	eventsSourceAddress = "127.0.0.1"
+)
+
+// NewEventsSource returns the source receiving the events of the cluster from the events watcher. The watcher
+// posts every revision of an event once and resumes from its checkpoint after a restart
+func NewEventsSource(input *adapters.Input) (id string, _ types.Source, tfs api.Transforms) {
+	spec := input.Events
+	inputID := helpers.MakeInputID(input.Name)
+	metaID := helpers.MakeID(inputID, "meta")
+
+	source := sources.NewHttpServer(eventsSourceAddress, constants.EventsWatcherPort)
+	source.Framing = &sources.Framing{Method: sources.FramingMethodNewlineDelimited}
+	source.Decoding = &sources.Decoding{Codec: codec.CodecTypeJSON}
+
+	tfs = api.Transforms{}
+	metaInput := EventsSourceID
+	if spec.Type != "" {
+		tfs[inputID] = transforms.NewFilter(fmt.Sprintf(`.type == "%s"`, spec.Type), EventsSourceID)
+		metaInput = inputID
+	}
+	tfs[metaID] = NewEventsInternalNormalization(metaInput)
+	input.Ids = append(input.Ids, metaID)
+	return EventsSourceID, source, tfs
+}
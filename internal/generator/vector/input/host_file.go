@@ -0,0 +1,65 @@
+package input
+
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sources"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+const (
+	// HostFileMultilineTimeoutMs is the multiline timeout of host file inputs when one is not specified
+	HostFileMultilineTimeoutMs = 1000
+)
+
+var hostFileMultilineModes = map[obs.HostFileMultilineMode]sources.FileMultilineMode{
+	obs.HostFileMultilineModeContinueThrough: sources.FileMultilineModeContinueThrough,
+	obs.HostFileMultilineModeContinuePast:    sources.FileMultilineModeContinuePast,
+	obs.HostFileMultilineModeHaltBefore:      sources.FileMultilineModeHaltBefore,
+	obs.HostFileMultilineModeHaltWith:        sources.FileMultilineModeHaltWith,
+}
+
+// NewHostFileSource returns a source tailing the files of the host file input
+func NewHostFileSource(input *adapters.Input) (id string, _ types.Source, tfs api.Transforms) {
+	spec := input.HostFile
+	tfs = api.Transforms{}
+	id = helpers.MakeInputID(input.Name)
+	metaID := helpers.MakeID(id, "meta")
+	f := sources.NewFile(spec.Paths...)
+	f.HostKey = "hostname"
+	f.GlobalMinimumCooldownMilliSeconds = GlobalMinimumCooldown
+	f.IgnoreOlderSecs = IgnoreOlderSecs
+	if spec.IgnoreOlder != nil {
+		f.IgnoreOlderSecs = int64(spec.IgnoreOlder.Seconds())
+	}
+	f.MaxLineBytes = MaxLineBytes
+	f.MaxReadBytes = MaxReadBytes
+	f.RotateWaitSecs = RotateWaitSecs
+	f.Multiline = hostFileMultiline(spec.Multiline)
+	tfs.Add(metaID, NewInternalNormalization(internalobs.HostFileInputLogSource, obs.InputTypeInfrastructure, id))
+	input.Ids = append(input.Ids, metaID)
+	return id, f, tfs
+}
+
+func hostFileMultiline(spec *obs.HostFileMultiline) *sources.FileMultiline {
+	if spec == nil {
+		return nil
+	}
+	mode, found := hostFileMultilineModes[spec.Mode]
+	if !found {
+		mode = sources.FileMultilineModeContinueThrough
+	}
+	multiline := &sources.FileMultiline{
+		StartPattern:     spec.StartPattern,
+		ConditionPattern: spec.ConditionPattern,
+		Mode:             mode,
+		TimeoutMs:        HostFileMultilineTimeoutMs,
+	}
+	if spec.Timeout != nil {
+		multiline.TimeoutMs = spec.Timeout.Milliseconds()
+	}
+	return multiline
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
 	v1 "github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/openshift/viaq/v1"
@@ -33,6 +34,145 @@ if match_any(string(._internal.kubernetes.namespace_name) ?? "", [r'^default$',
 	setKubernetesContainerIOStream = `if exists(._internal.stream) {._internal.kubernetes.container_iostream = ._internal.stream}`
 	setEnvelopeToStructured        = `. = {"_internal": {"structured": .}}`
 	setHostName                    = `._internal.hostname = get_env_var("VECTOR_SELF_NODE_NAME") ?? ""`
+	setEnvelopeToKubernetesEvent   = `. = {"_internal": {"kubernetes": {"event": .}}}`
+
+	// dropHttpServerRequestFields removes the request fields added by the http_server source receiving the events and
+	// keeps the timestamp, which is the one of the record when it has one
+	dropHttpServerRequestFields = `del(.path)
+del(.source_type)`
+	// dropHttpServerFields removes the fields added by the http_server source receiving the events
+	dropHttpServerFields = dropHttpServerRequestFields + `
+del(.timestamp)`
+
+	// normalizeOTLPLogRecord moves the resource attributes, body, attributes and trace context of an OTLP log record to
+	// the structured fields. The resource attributes are supplied by the sender and are only mapped to the kubernetes
+	// fields of the internal model, which identify the namespace of the logs to outputs such as LokiStack, when the
+	// receiver opts in with mapOTLPKubernetesAttributes
+	normalizeOTLPLogRecord = `
+if is_object(._internal.message) {
+  ._internal.structured = del(._internal.message)
+} else if exists(._internal.message) && !is_string(._internal.message) {
+  ._internal.message = encode_json(._internal.message)
+}
+if exists(._internal.resources) {
+  ._internal.structured.resources = del(._internal.resources)
+}
+if exists(._internal.attributes) {
+  ._internal.structured.attributes = del(._internal.attributes)
+}
+if exists(._internal.trace_id) {
+  ._internal.structured.trace_id = del(._internal.trace_id)
+}
+if exists(._internal.span_id) {
+  ._internal.structured.span_id = del(._internal.span_id)
+}
+if exists(._internal.flags) {
+  ._internal.structured.trace_flags = del(._internal.flags)
+}
+if is_string(._internal.severity_text) && ._internal.severity_text != "" {
+  ._internal.level = downcase(string!(._internal.severity_text))
+} else if is_integer(._internal.severity_number) && int!(._internal.severity_number) > 0 {
+  severity = int!(._internal.severity_number)
+  if severity <= 4 {
+    ._internal.level = "trace"
+  } else if severity <= 8 {
+    ._internal.level = "debug"
+  } else if severity <= 12 {
+    ._internal.level = "info"
+  } else if severity <= 16 {
+    ._internal.level = "warn"
+  } else if severity <= 20 {
+    ._internal.level = "error"
+  } else {
+    ._internal.level = "critical"
+  }
+} else if !is_string(._internal.message) {
+  ._internal.level = "default"
+}
+`
+
+	// mapOTLPKubernetesAttributes maps the kubernetes resource attributes of a normalized OTLP log record to the
+	// kubernetes fields of the internal model
+	mapOTLPKubernetesAttributes = `
+resources = object(._internal.structured.resources) ?? {}
+if is_string(resources."k8s.namespace.name") {
+  ._internal.kubernetes.namespace_name = resources."k8s.namespace.name"
+}
+if is_string(resources."k8s.pod.name") {
+  ._internal.kubernetes.pod_name = resources."k8s.pod.name"
+}
+if is_string(resources."k8s.pod.uid") {
+  ._internal.kubernetes.pod_id = resources."k8s.pod.uid"
+}
+if is_string(resources."k8s.container.name") {
+  ._internal.kubernetes.container_name = resources."k8s.container.name"
+}
+if is_string(resources."k8s.node.name") {
+  ._internal.kubernetes.host = resources."k8s.node.name"
+}
+`
+
+	// liftStructuredFields moves the well known fields of a decoded JSON record to the internal model
+	liftStructuredFields = `
+._internal.timestamp = del(._internal.structured.timestamp)
+if is_string(._internal.timestamp) {
+  ._internal.timestamp = parse_timestamp(string!(._internal.timestamp), "%+") ?? now()
+}
+if is_string(._internal.structured.message) {
+  ._internal.message = del(._internal.structured.message)
+}
+if is_string(._internal.structured.level) {
+  ._internal.level = downcase(string!(._internal.structured.level))
+} else if !exists(._internal.message) {
+  ._internal.level = "default"
+}
+`
+
+	// normalizeKafkaRecord tags a consumed record with the topic, partition and offset it was consumed from and
+	// decodes a JSON object value into the structured fields
+	normalizeKafkaRecord = `
+._internal.kafka = {"topic": del(._internal.topic), "partition": del(._internal.partition), "offset": del(._internal.offset)}
+del(._internal.message_key)
+del(._internal.headers)
+structured, err = parse_json(string(._internal.message) ?? "")
+if err == null && is_object(structured) {
+  ._internal.structured = structured
+  if is_string(._internal.structured.message) {
+    ._internal.message = del(._internal.structured.message)
+  }
+  if is_string(._internal.structured.level) {
+    ._internal.level = downcase(string!(._internal.structured.level))
+  }
+}
+`
+
+	// normalizeKubernetesEvent sets the message, namespace, timestamp and level of a listed kubernetes event
+	normalizeKubernetesEvent = `
+# escape 'new line' symbol see: LOG-8090
+._internal.message = replace(string(del(._internal.kubernetes.event.message)) ?? "", "\n", s'\n')
+if is_string(._internal.kubernetes.event.involvedObject.namespace) {
+  ._internal.kubernetes.namespace_name = ._internal.kubernetes.event.involvedObject.namespace
+} else if is_string(._internal.kubernetes.event.metadata.namespace) {
+  ._internal.kubernetes.namespace_name = ._internal.kubernetes.event.metadata.namespace
+}
+# lastTimestamp -> firstTimestamp -> eventTime -> creationTimestamp
+ts = ._internal.kubernetes.event.lastTimestamp
+if ts == null || ts == "" || ts == "0001-01-01T00:00:00Z" {
+  ts = ._internal.kubernetes.event.firstTimestamp
+}
+if ts == null || ts == "" || ts == "0001-01-01T00:00:00Z" {
+  ts = ._internal.kubernetes.event.eventTime
+}
+if ts == null || ts == "" {
+  ts = ._internal.kubernetes.event.metadata.creationTimestamp
+}
+._internal.timestamp = parse_timestamp(string(ts) ?? "", "%+") ?? now()
+if ._internal.kubernetes.event.type == "Warning" {
+  ._internal.level = "warn"
+} else {
+  ._internal.level = "info"
+}
+`
 
 	// Fallback: when Vector fails to annotate pod metadata (e.g. pod already deleted),
 	// extract kubernetes metadata from the log file path which is always available.
@@ -103,8 +243,8 @@ func NewJournalInternalNormalization(logSource interface{}, envelopeVrl, inputs
 	return transforms.NewRemap(strings.Join(vrls, "\n"), inputs)
 }
 
-// NewJournalInternalNormalization returns configuration elements to normalize journal log entries to an internal, common data model
-func NewReceiverInternalNormalization(logSource interface{}, envelopeVrl, inputs string, addVRLs ...string) types.Transform {
+// NewReceiverInternalNormalization returns configuration elements to normalize receiver log entries to an internal, common data model
+func NewReceiverInternalNormalization(logSource interface{}, envelopeVrl string, inputs []string, addVRLs ...string) types.Transform {
 	vrls := []string{
 		envelopeVrl,
 		fmt.Sprintf(fmtLogSource, logSource),
@@ -113,5 +253,78 @@ func NewReceiverInternalNormalization(logSource interface{}, envelopeVrl, inputs
 		`._internal.message = del(._internal.structured.message)`,
 	}
 	vrls = append(vrls, addVRLs...)
+	return transforms.NewRemap(strings.Join(vrls, "\n"), inputs...)
+}
+
+// NewApplicationReceiverInternalNormalization returns configuration elements to normalize application log entries
+// received by an HTTP receiver to an internal, common data model
+func NewApplicationReceiverInternalNormalization(logSource string, format obs.HTTPReceiverFormat, inputs string, addVRLs ...string) types.Transform {
+	vrls := []string{setEnvelope}
+	if format != obs.HTTPReceiverFormatText {
+		vrls = []string{dropHttpServerRequestFields, setEnvelopeToStructured, liftStructuredFields}
+	}
+	vrls = append(vrls,
+		fmt.Sprintf(fmtLogSource, logSource),
+		fmt.Sprintf(fmtLogType, obs.InputTypeApplication),
+		setHostName,
+		setClusterID,
+		setOpenshiftSequence,
+		v1.SetLogLevel,
+	)
+	vrls = append(vrls, addVRLs...)
+	return transforms.NewRemap(strings.Join(vrls, "\n"), inputs)
+}
+
+// NewOTLPReceiverInternalNormalization returns configuration elements to normalize OpenTelemetry log records
+// received by an OTLP receiver to an internal, common data model
+func NewOTLPReceiverInternalNormalization(inputs string, mapKubernetesAttributes bool, addVRLs ...string) types.Transform {
+	vrls := []string{
+		setEnvelope,
+		fmt.Sprintf(fmtLogSource, internalobs.OTLPReceiverLogSource),
+		fmt.Sprintf(fmtLogType, obs.InputTypeApplication),
+		setHostName,
+		setClusterID,
+		setOpenshiftSequence,
+		normalizeOTLPLogRecord,
+	}
+	if mapKubernetesAttributes {
+		vrls = append(vrls, mapOTLPKubernetesAttributes)
+	}
+	vrls = append(vrls, v1.SetLogLevel)
+	vrls = append(vrls, addVRLs...)
+	return transforms.NewRemap(strings.Join(vrls, "\n"), inputs)
+}
+
+// NewKafkaInternalNormalization returns configuration elements to normalize records consumed from Kafka
+// to an internal, common data model
+func NewKafkaInternalNormalization(inputs string, addVRLs ...string) types.Transform {
+	vrls := []string{
+		setEnvelope,
+		fmt.Sprintf(fmtLogSource, internalobs.KafkaInputLogSource),
+		fmt.Sprintf(fmtLogType, obs.InputTypeApplication),
+		setHostName,
+		setClusterID,
+		setOpenshiftSequence,
+		normalizeKafkaRecord,
+		v1.SetLogLevel,
+	}
+	vrls = append(vrls, addVRLs...)
+	return transforms.NewRemap(strings.Join(vrls, "\n"), inputs)
+}
+
+// NewEventsInternalNormalization returns configuration elements to normalize kubernetes events posted by the
+// events watcher to an internal, common data model
+func NewEventsInternalNormalization(inputs string, addVRLs ...string) types.Transform {
+	vrls := []string{
+		dropHttpServerFields,
+		setEnvelopeToKubernetesEvent,
+		fmt.Sprintf(fmtLogSource, internalobs.EventsInputLogSource),
+		fmt.Sprintf(fmtLogType, obs.InputTypeInfrastructure),
+		setHostName,
+		setClusterID,
+		setOpenshiftSequence,
+		normalizeKubernetesEvent,
+	}
+	vrls = append(vrls, addVRLs...)
 	return transforms.NewRemap(strings.Join(vrls, "\n"), inputs)
 }
@@ -1,6 +1,8 @@
 package input
 
 import (
+	"strconv"
+
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
@@ -10,9 +12,30 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 )
 
+const (
+	// journalPriorityField is the journal field of the syslog priority of an entry
+	journalPriorityField = "PRIORITY"
+)
+
+// journalPriorities are the syslog priorities of journal entries in order of descending severity
+var journalPriorities = []obs.JournalPriority{
+	obs.JournalPriorityEmergency,
+	obs.JournalPriorityAlert,
+	obs.JournalPriorityCritical,
+	obs.JournalPriorityError,
+	obs.JournalPriorityWarning,
+	obs.JournalPriorityNotice,
+	obs.JournalPriorityInfo,
+	obs.JournalPriorityDebug,
+}
+
 func NewJournalInput(input *adapters.Input) (id string, source types.Source, tfs api.Transforms) {
 	tfs = api.Transforms{}
-	source = sources.NewJournalD()
+	journal := sources.NewJournalD()
+	if input.Infrastructure != nil && input.Infrastructure.Tuning != nil {
+		journalTuning(&journal, input.Infrastructure.Tuning.Journal)
+	}
+	source = journal
 	id = helpers.MakeInputID(input.Name, "journal")
 	metaID := helpers.MakeID(id, "meta")
 	tfs.Add(metaID, NewJournalInternalNormalization(obs.InfrastructureSourceNode, setEnvelope, id,
@@ -25,3 +48,36 @@ func NewJournalInput(input *adapters.Input) (id string, source types.Source, tfs
 	input.Ids = append(input.Ids, metaID)
 	return id, source, tfs
 }
+
+// journalTuning limits the entries read from the journal. The minimum priority excludes the entries of all lower
+// priorities because entries matching any include rule are read regardless of the included units
+func journalTuning(journal *sources.Journald, spec *obs.JournalInputTuningSpec) {
+	if spec == nil {
+		return
+	}
+	journal.IncludeUnits = spec.IncludeUnits
+	journal.ExcludeUnits = spec.ExcludeUnits
+	journal.CurrentBootOnly = spec.CurrentBootOnly
+	if excluded := journalPrioritiesBelow(spec.MinPriority); len(excluded) > 0 {
+		journal.ExcludeMatches = map[string][]string{
+			journalPriorityField: excluded,
+		}
+	}
+}
+
+// journalPrioritiesBelow returns the numeric values of the priorities lower than the given priority
+func journalPrioritiesBelow(minPriority obs.JournalPriority) (values []string) {
+	if minPriority == "" {
+		return nil
+	}
+	found := false
+	for i, p := range journalPriorities {
+		if found {
+			values = append(values, strconv.Itoa(i))
+		}
+		if p == minPriority {
+			found = true
+		}
+	}
+	return values
+}
@@ -0,0 +1,77 @@
+package input
+
+import (
+	"net/url"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sources"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/transport"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/common/tls"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	kafkaoutput "github.com/openshift/cluster-logging-operator/internal/generator/vector/output/kafka"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+)
+
+// NewKafkaSource returns a consumer of the topics of the kafka input
+func NewKafkaSource(input *adapters.Input, secrets observability.Secrets, op utils.Options) (id string, source types.Source, tfs api.Transforms) {
+	spec := input.Kafka
+	consumer := sources.NewKafka(kafkaBootstrapServers(spec.Brokers), spec.GroupId, spec.Topics...)
+	consumer.Sasl = kafkaSasl(spec.Authentication)
+	if kafkaTlsBrokers(spec.Brokers) {
+		consumer.TLS = tls.NewTlsEnabled(input, secrets, op)
+		if consumer.TLS == nil {
+			consumer.TLS = &transport.TlsEnabled{
+				TLS:     *tls.SetTLSProfile(&transport.TLS{}, op),
+				Enabled: true,
+			}
+		}
+	}
+	id = helpers.MakeInputID(input.Name)
+	metaID := helpers.MakeID(id, "meta")
+	input.Ids = append(input.Ids, metaID)
+	return id, consumer, api.Transforms{
+		metaID: NewKafkaInternalNormalization(id),
+	}
+}
+
+// kafkaBootstrapServers converts the broker URLs to the host:port list of bootstrap servers
+func kafkaBootstrapServers(brokers []obs.BrokerURL) string {
+	hosts := []string{}
+	for _, b := range brokers {
+		if u, _ := url.Parse(string(b)); u != nil {
+			hosts = append(hosts, u.Host)
+		}
+	}
+	return strings.Join(hosts, ",")
+}
+
+// kafkaTlsBrokers returns true when the brokers are connected to using TLS
+func kafkaTlsBrokers(brokers []obs.BrokerURL) bool {
+	for _, b := range brokers {
+		if !strings.HasPrefix(string(b), "tls:") {
+			return false
+		}
+	}
+	return len(brokers) > 0
+}
+
+func kafkaSasl(spec *obs.KafkaAuthentication) *sources.KafkaSasl {
+	if spec == nil || spec.SASL == nil || spec.SASL.Username == nil || spec.SASL.Password == nil {
+		return nil
+	}
+	sasl := &sources.KafkaSasl{
+		Enabled:   true,
+		Username:  helpers.SecretFrom(spec.SASL.Username),
+		Password:  helpers.SecretFrom(spec.SASL.Password),
+		Mechanism: kafkaoutput.SASLMechanismPlain,
+	}
+	if spec.SASL.Mechanism != "" {
+		sasl.Mechanism = spec.SASL.Mechanism
+	}
+	return sasl
+}
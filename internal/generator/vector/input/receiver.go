@@ -12,40 +12,161 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/codec"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/transport"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/common/tls"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/metrics"
 	"github.com/openshift/cluster-logging-operator/internal/utils"
 )
 
-func NewViaqReceiverSource(spec *adapters.Input, resNames factory.ForwarderResourceNames, secrets observability.Secrets, op utils.Options) (id string, source types.Source, tfs api.Transforms) {
+func NewViaqReceiverSource(spec *adapters.Input, resNames factory.ForwarderResourceNames, secrets observability.Secrets, op utils.Options) (srcs api.Sources, tfs api.Transforms) {
 	tfs = api.Transforms{}
 	base := helpers.MakeInputID(spec.Name)
 	metaID := helpers.MakeID(base, "meta")
 
-	serverTls := tls.NewTlsEnabled(spec, secrets, op)
+	serverTls := withClientVerification(tls.NewTlsEnabled(spec, secrets, op), spec.Receiver.Authentication)
 	switch spec.Receiver.Type {
 	case obs.ReceiverTypeSyslog:
-		server := sources.NewSyslogServer(helpers.ListenOnAllLocalInterfacesAddress(), spec.Receiver.Port, sources.SyslogModeTcp)
-		server.TLS = serverTls
-		tfs[metaID] = NewReceiverInternalNormalization(obs.ReceiverTypeSyslog, setEnvelopeToStructured, base)
-		spec.Ids = append(spec.Ids, metaID)
-		return base, server, tfs
+		return newSyslogReceiverSource(spec, base, metaID, serverTls)
+	case obs.ReceiverTypeOTLP:
+		return newOTLPReceiverSource(spec, base, metaID, serverTls)
 	case obs.ReceiverTypeHTTP:
+		if spec.Receiver.HTTP != nil && spec.Receiver.HTTP.Format != obs.HTTPReceiverFormatKubeAPIAudit {
+			return newApplicationReceiverSource(spec, base, metaID, serverTls, op)
+		}
 		itemsID := helpers.MakeID(base, "items")
-		tfs[itemsID] = newItemsTransform(base, base)
 		server := sources.NewHttpServer(helpers.ListenOnAllLocalInterfacesAddress(), spec.Receiver.Port)
 		server.TLS = serverTls
 		server.Decoding = &sources.Decoding{
 			Codec: codec.CodecTypeJSON,
 		}
+		authorizedID, authTfs := withBearerTokenAuth(server, spec.Name, base, spec.Receiver.Authentication, op)
+		tfs.Merge(authTfs)
+		tfs[itemsID] = newItemsTransform(base, authorizedID)
 		tfs[metaID] = NewAuditInternalNormalization(obs.AuditSourceKube, itemsID, false)
 		spec.Ids = append(spec.Ids, metaID)
-		return base, server, tfs
+		return api.Sources{base: server}, tfs
 	default:
 		panic(fmt.Sprintf("Unsupported receiver type %q", spec.Receiver.Type))
 	}
 }
 
+// withClientVerification requires clients to present a certificate signed by the client CA of the receiver authentication
+func withClientVerification(serverTls *transport.TlsEnabled, auth *obs.ReceiverAuthentication) *transport.TlsEnabled {
+	if serverTls == nil || auth == nil || auth.ClientCA == nil {
+		return serverTls
+	}
+	serverTls.CAFile = tls.ValuePath(auth.ClientCA, "%s")
+	serverTls.VerifyCertificate = utils.GetPtr(true)
+	return serverTls
+}
+
+// bearerTokenAuthVRL authorizes requests bearing the token of the environment variable. Rejected requests are logged and
+// aborted so they are routed to the dropped output of the transform, which counts them.
+// The token is read from the environment instead of the generated configuration so its value is never parsed as VRL
+// The digests of the header and of the expected value are compared instead of the values, so the time of the comparison
+// does not reveal how many leading characters of a presented token are correct
+const bearerTokenAuthVRL = `
+token = get_env_var("%s") ?? ""
+authorization = string(del(.authorization)) ?? ""
+if token == "" || sha2(authorization) != sha2("Bearer " + token) {
+  log("Rejected request to receiver %s: missing or invalid bearer token", level: "warn", rate_limit_secs: 60)
+  abort
+}
+`
+
+// withBearerTokenAuth adds the authorization header of requests to the events of the server and returns the ID of the
+// transform which only forwards the requests bearing the token of the receiver authentication, along with its transforms
+func withBearerTokenAuth(server *sources.HttpServer, inputName, base string, auth *obs.ReceiverAuthentication, op utils.Options) (string, api.Transforms) {
+	if auth == nil || auth.Token == nil {
+		return base, api.Transforms{}
+	}
+	server.Headers = []string{"authorization"}
+	authID := helpers.MakeID(base, "auth")
+	remap := transforms.NewRemap(fmt.Sprintf(bearerTokenAuthVRL, observability.ReceiverTokenEnvVar(inputName), inputName), base)
+	remap.RerouteDropped = true
+	return authID, api.Transforms{
+		authID:                             remap,
+		metrics.RejectedRequestsID(authID): metrics.NewRejectedRequests(inputName, authID, op),
+	}
+}
+
+// newSyslogReceiverSource returns syslog servers listening on the transport protocols of the receiver mode.
+// TLS is only enabled in tcp mode
+func newSyslogReceiverSource(spec *adapters.Input, base, metaID string, serverTls *transport.TlsEnabled) (api.Sources, api.Transforms) {
+	address := helpers.ListenOnAllLocalInterfacesAddress()
+	srcs := api.Sources{}
+	inputs := []string{base}
+	switch observability.SyslogReceiverMode(spec.Receiver) {
+	case obs.SyslogReceiverModeUDP:
+		srcs[base] = sources.NewSyslogServer(address, spec.Receiver.Port, sources.SyslogModeUdp)
+	case obs.SyslogReceiverModeBoth:
+		srcs[base] = sources.NewSyslogServer(address, spec.Receiver.Port, sources.SyslogModeTcp)
+		udpID := helpers.MakeID(base, "udp")
+		srcs[udpID] = sources.NewSyslogServer(address, spec.Receiver.Port, sources.SyslogModeUdp)
+		inputs = append(inputs, udpID)
+	default:
+		server := sources.NewSyslogServer(address, spec.Receiver.Port, sources.SyslogModeTcp)
+		server.TLS = serverTls
+		srcs[base] = server
+	}
+	spec.Ids = append(spec.Ids, metaID)
+	return srcs, api.Transforms{
+		metaID: NewReceiverInternalNormalization(obs.ReceiverTypeSyslog, setEnvelopeToStructured, inputs),
+	}
+}
+
+// otlpDisabledListenerAddress is the address of the listener of an OTLP protocol which is not enabled. The source
+// requires both the gRPC and HTTP listeners so it is bound to an ephemeral port on the loopback interface. The port
+// is not exposed by the service of the receiver and the collector pod does not use the host network, so the listener
+// is only reachable from the containers of the collector pod
+const otlpDisabledListenerAddress = "127.0.0.1:0"
+
+// newOTLPReceiverSource returns an OpenTelemetry server listening on the OTLP protocols of the receiver
+func newOTLPReceiverSource(spec *adapters.Input, base, metaID string, serverTls *transport.TlsEnabled) (api.Sources, api.Transforms) {
+	listen := func(port int32) *sources.OpenTelemetryListener {
+		return &sources.OpenTelemetryListener{
+			Address: fmt.Sprintf("%s:%d", helpers.ListenOnAllLocalInterfacesAddress(), port),
+			TLS:     serverTls,
+		}
+	}
+	disabled := &sources.OpenTelemetryListener{Address: otlpDisabledListenerAddress}
+	var server *sources.OpenTelemetry
+	switch observability.OTLPReceiverProtocol(spec.Receiver) {
+	case obs.OTLPReceiverProtocolGRPC:
+		server = sources.NewOpenTelemetry(listen(spec.Receiver.Port), disabled)
+	case obs.OTLPReceiverProtocolBoth:
+		server = sources.NewOpenTelemetry(listen(spec.Receiver.OTLP.GRPCPort), listen(spec.Receiver.Port))
+	default:
+		server = sources.NewOpenTelemetry(disabled, listen(spec.Receiver.Port))
+	}
+	spec.Ids = append(spec.Ids, metaID)
+	return api.Sources{base: server}, api.Transforms{
+		metaID: NewOTLPReceiverInternalNormalization(helpers.MakeRouteInputID(base, sources.OpenTelemetryLogsOutput), spec.Receiver.OTLP != nil && spec.Receiver.OTLP.MapKubernetesAttributes),
+	}
+}
+
+// newApplicationReceiverSource returns an HTTP server which accepts application logs in the json, ndjson or text formats
+func newApplicationReceiverSource(spec *adapters.Input, base, metaID string, serverTls *transport.TlsEnabled, op utils.Options) (api.Sources, api.Transforms) {
+	server := sources.NewHttpServer(helpers.ListenOnAllLocalInterfacesAddress(), spec.Receiver.Port)
+	server.TLS = serverTls
+	authorizedID, tfs := withBearerTokenAuth(server, spec.Name, base, spec.Receiver.Authentication, op)
+	format := spec.Receiver.HTTP.Format
+	switch format {
+	case obs.HTTPReceiverFormatNDJSON:
+		server.Framing = &sources.Framing{Method: sources.FramingMethodNewlineDelimited}
+		server.Decoding = &sources.Decoding{Codec: codec.CodecTypeJSON}
+	case obs.HTTPReceiverFormatText:
+		server.Framing = &sources.Framing{Method: sources.FramingMethodNewlineDelimited}
+		server.Decoding = &sources.Decoding{Codec: codec.CodecTypeBytes}
+	default:
+		server.Decoding = &sources.Decoding{Codec: codec.CodecTypeJSON}
+	}
+	spec.Ids = append(spec.Ids, metaID)
+	tfs[metaID] = NewApplicationReceiverInternalNormalization(observability.ReceiverLogSource(spec.Receiver.HTTP), format, authorizedID)
+	return api.Sources{base: server}, tfs
+}
+
 func newItemsTransform(id, inputs string) types.Transform {
 	return transforms.NewRemap(`
 if exists(.items) {
@@ -120,7 +120,21 @@ func NewSource(input *adapters.Input, resNames factory.ForwarderResourceNames, s
 			tfs.Merge(ctfs)
 		}
 	case obs.InputTypeReceiver:
-		sourceId, source, ctfs := NewViaqReceiverSource(input, resNames, secrets, op)
+		srcs, ctfs := NewViaqReceiverSource(input, resNames, secrets, op)
+		for sourceId, source := range srcs {
+			inputSources.Add(sourceId, source)
+		}
+		tfs.Merge(ctfs)
+	case obs.InputTypeKafka:
+		sourceId, source, ctfs := NewKafkaSource(input, secrets, op)
+		inputSources.Add(sourceId, source)
+		tfs.Merge(ctfs)
+	case obs.InputTypeHostFile:
+		sourceId, source, ctfs := NewHostFileSource(input)
+		inputSources.Add(sourceId, source)
+		tfs.Merge(ctfs)
+	case obs.InputTypeEvents:
+		sourceId, source, ctfs := NewEventsSource(input)
 		inputSources.Add(sourceId, source)
 		tfs.Merge(ctfs)
 	}
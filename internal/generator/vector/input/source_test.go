@@ -249,6 +249,26 @@ var _ = Describe("inputs", func() {
 		},
 			"application_with_matchLabels.toml",
 		),
+		Entry("with an application that specs a namespace selector", obs.InputSpec{
+			Name: "my-app",
+			Type: obs.InputTypeApplication,
+			Application: &obs.Application{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						"team": "payments",
+					},
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{
+							Key:      "env",
+							Operator: metav1.LabelSelectorOpIn,
+							Values:   []string{"staging", "prod"},
+						},
+					},
+				},
+			},
+		},
+			"application_with_namespaceSelector.toml",
+		),
 		Entry("with an infrastructure input should generate a container and journal source", obs.InputSpec{
 			Name: string(obs.InputTypeInfrastructure),
 			Type: obs.InputTypeInfrastructure,
@@ -280,6 +300,23 @@ var _ = Describe("inputs", func() {
 		},
 			"infrastructure_journal.toml",
 		),
+		Entry("with an infrastructure input for node with journal tuning should generate a journal source with unit and priority filters", obs.InputSpec{
+			Name: "myinfra",
+			Type: obs.InputTypeInfrastructure,
+			Infrastructure: &obs.Infrastructure{
+				Sources: []obs.InfrastructureSource{obs.InfrastructureSourceNode},
+				Tuning: &obs.InfrastructureInputTuningSpec{
+					Journal: &obs.JournalInputTuningSpec{
+						IncludeUnits:    []string{"kubelet", "crio.service"},
+						ExcludeUnits:    []string{"systemd-journald"},
+						MinPriority:     obs.JournalPriorityWarning,
+						CurrentBootOnly: utils.GetPtr(false),
+					},
+				},
+			},
+		},
+			"infrastructure_journal_with_tuning.toml",
+		),
 		Entry("with an audit input should generate file sources", obs.InputSpec{
 			Name:  string(obs.InputTypeAudit),
 			Type:  obs.InputTypeAudit,
@@ -352,6 +389,18 @@ var _ = Describe("inputs", func() {
 		},
 			"audit_host_with_ignore_older.toml",
 		),
+		Entry("with an audit input for auditd with record types should filter the auditd records", obs.InputSpec{
+			Name: "myaudit",
+			Type: obs.InputTypeAudit,
+			Audit: &obs.Audit{
+				Sources: []obs.AuditSource{obs.AuditSourceAuditd},
+				Tuning: &obs.AuditInputTuningSpec{
+					AuditdRecordTypes: []string{"SYSCALL", "EXECVE"},
+				},
+			},
+		},
+			"audit_host_with_record_types.toml",
+		),
 		Entry("with an http audit receiver input should generate an http receiver audit source", obs.InputSpec{
 			Type: obs.InputTypeReceiver,
 			Name: "myreceiver",
@@ -375,6 +424,89 @@ var _ = Describe("inputs", func() {
 		},
 			"receiver_http_audit.toml",
 		),
+		Entry("with an http json receiver input should generate an http receiver application source", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeHTTP,
+				Port: 12345,
+				HTTP: &obs.HTTPReceiver{
+					Format: obs.HTTPReceiverFormatJSON,
+				},
+				TLS: &obs.InputTLSSpec{
+					Certificate: &obs.ValueReference{
+						Key:        constants.ClientCertKey,
+						SecretName: secretName,
+					},
+					Key: &obs.SecretReference{
+						Key:        constants.ClientPrivateKey,
+						SecretName: secretName,
+					},
+				},
+			},
+		},
+			"receiver_http_json.toml",
+		),
+		Entry("with an http ndjson receiver input should generate a newline delimited http receiver with the log source", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeHTTP,
+				Port: 12345,
+				HTTP: &obs.HTTPReceiver{
+					Format:    obs.HTTPReceiverFormatNDJSON,
+					LogSource: "myagent",
+				},
+			},
+		},
+			"receiver_http_ndjson.toml",
+		),
+		Entry("with an http receiver with authentication should generate an http receiver verifying client certificates and bearer tokens", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeHTTP,
+				Port: 12345,
+				HTTP: &obs.HTTPReceiver{
+					Format: obs.HTTPReceiverFormatKubeAPIAudit,
+				},
+				TLS: &obs.InputTLSSpec{
+					Certificate: &obs.ValueReference{
+						Key:        constants.ClientCertKey,
+						SecretName: secretName,
+					},
+					Key: &obs.SecretReference{
+						Key:        constants.ClientPrivateKey,
+						SecretName: secretName,
+					},
+				},
+				Authentication: &obs.ReceiverAuthentication{
+					ClientCA: &obs.ValueReference{
+						Key:           "ca.crt",
+						ConfigMapName: "clients",
+					},
+					Token: &obs.SecretReference{
+						Key:        "token",
+						SecretName: "receiver-tokens",
+					},
+				},
+			},
+		},
+			"receiver_http_authentication.toml",
+		),
+		Entry("with an http text receiver input should generate a newline delimited http receiver of raw lines", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeHTTP,
+				Port: 12345,
+				HTTP: &obs.HTTPReceiver{
+					Format: obs.HTTPReceiverFormatText,
+				},
+			},
+		},
+			"receiver_http_text.toml",
+		),
 		Entry("with a syslog receiver input should generate VIAQ syslog receiver", obs.InputSpec{
 			Type: obs.InputTypeReceiver,
 			Name: "myreceiver",
@@ -403,6 +535,81 @@ var _ = Describe("inputs", func() {
 		},
 			"receiver_syslog.toml",
 		),
+		Entry("with a syslog receiver in udp mode should generate a VIAQ syslog receiver without TLS", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type:   obs.ReceiverTypeSyslog,
+				Port:   12345,
+				Syslog: &obs.SyslogReceiver{Mode: obs.SyslogReceiverModeUDP},
+			},
+		},
+			"receiver_syslog_udp.toml",
+		),
+		Entry("with a syslog receiver in both mode should generate VIAQ syslog receivers for TCP and UDP", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type:   obs.ReceiverTypeSyslog,
+				Port:   12345,
+				Syslog: &obs.SyslogReceiver{Mode: obs.SyslogReceiverModeBoth},
+			},
+		},
+			"receiver_syslog_both.toml",
+		),
+		Entry("with an otlp receiver should generate an OpenTelemetry source listening on HTTP", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeOTLP,
+				Port: 4318,
+				TLS: &obs.InputTLSSpec{
+					Certificate: &obs.ValueReference{
+						Key:        constants.ClientCertKey,
+						SecretName: secretName,
+					},
+					Key: &obs.SecretReference{
+						Key:        constants.ClientPrivateKey,
+						SecretName: secretName,
+					},
+				},
+			},
+		},
+			"receiver_otlp_http.toml",
+		),
+		Entry("with an otlp receiver using grpc should generate an OpenTelemetry source listening on gRPC", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeOTLP,
+				Port: 4317,
+				OTLP: &obs.OTLPReceiver{Protocol: obs.OTLPReceiverProtocolGRPC},
+			},
+		},
+			"receiver_otlp_grpc.toml",
+		),
+		Entry("with an otlp receiver using both protocols should generate an OpenTelemetry source listening on HTTP and gRPC", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeOTLP,
+				Port: 4318,
+				OTLP: &obs.OTLPReceiver{Protocol: obs.OTLPReceiverProtocolBoth, GRPCPort: 4317},
+			},
+		},
+			"receiver_otlp_both.toml",
+		),
+		Entry("with an otlp receiver mapping the kubernetes attributes should map the resource attributes to the kubernetes fields", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeOTLP,
+				Port: 4318,
+				OTLP: &obs.OTLPReceiver{MapKubernetesAttributes: true},
+			},
+		},
+			"receiver_otlp_kubernetes_attributes.toml",
+		),
 		Entry("with a syslog receiver and tls from configmaps", obs.InputSpec{
 			Type: obs.InputTypeReceiver,
 			Name: "myreceiver",
@@ -427,6 +634,83 @@ var _ = Describe("inputs", func() {
 		},
 			"receiver_syslog_tls_from_configmap.toml",
 		),
+		Entry("with a syslog receiver with a client CA should generate a syslog receiver verifying client certificates", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeSyslog,
+				Port: 12345,
+				TLS: &obs.InputTLSSpec{
+					Certificate: &obs.ValueReference{
+						Key:        constants.ClientCertKey,
+						SecretName: secretName,
+					},
+					Key: &obs.SecretReference{
+						Key:        constants.ClientPrivateKey,
+						SecretName: secretName,
+					},
+				},
+				Authentication: &obs.ReceiverAuthentication{
+					ClientCA: &obs.ValueReference{
+						Key:           "ca.crt",
+						ConfigMapName: "clients",
+					},
+				},
+			},
+		},
+			"receiver_syslog_client_ca.toml",
+		),
+		Entry("with a kafka input should generate a kafka consumer using SASL over TLS", obs.InputSpec{
+			Type: obs.InputTypeKafka,
+			Name: "mykafka",
+			Kafka: &obs.KafkaInput{
+				Brokers: []obs.BrokerURL{"tls://broker1.example.com:9093", "tls://broker2.example.com:9093"},
+				Topics:  []string{"app-logs", "team-logs"},
+				GroupId: "openshift-logging",
+				Authentication: &obs.KafkaAuthentication{
+					SASL: &obs.SASLAuthentication{
+						Username:  &obs.SecretReference{Key: "username", SecretName: "kafka-creds"},
+						Password:  &obs.SecretReference{Key: "password", SecretName: "kafka-creds"},
+						Mechanism: "SCRAM-SHA-512",
+					},
+				},
+				TLS: &obs.InputTLSSpec{
+					CA: &obs.ValueReference{
+						Key:           "ca-bundle.crt",
+						ConfigMapName: "kafka-ca",
+					},
+				},
+			},
+		},
+			"kafka.toml",
+		),
+		Entry("with a kafka input using tcp brokers should generate a kafka consumer without TLS", obs.InputSpec{
+			Type: obs.InputTypeKafka,
+			Name: "mykafka",
+			Kafka: &obs.KafkaInput{
+				Brokers: []obs.BrokerURL{"tcp://broker1.example.com:9092"},
+				Topics:  []string{"app-logs"},
+				GroupId: "openshift-logging",
+			},
+		},
+			"kafka_tcp.toml",
+		),
+		Entry("with a hostFile input should generate a file source with multiline rules", obs.InputSpec{
+			Type: obs.InputTypeHostFile,
+			Name: "myfiles",
+			HostFile: &obs.HostFileInput{
+				Paths: []string{"/var/log/acme/*.log", "/var/log/other/agent.log"},
+				Multiline: &obs.HostFileMultiline{
+					StartPattern:     `^\d{4}-\d{2}-\d{2}`,
+					ConditionPattern: `^\d{4}-\d{2}-\d{2}`,
+					Mode:             obs.HostFileMultilineModeHaltBefore,
+					Timeout:          &metav1.Duration{Duration: 2 * time.Second},
+				},
+				IgnoreOlder: &metav1.Duration{Duration: 24 * time.Hour},
+			},
+		},
+			"host_file.toml",
+		),
 		Entry("application input with a MaxMessageSize", obs.InputSpec{
 			Name: "my_app",
 			Type: obs.InputTypeApplication,
@@ -469,4 +753,37 @@ var _ = Describe("inputs", func() {
 			"infrastructure_container_with_throttle.toml",
 		),
 	)
+
+	It("with events inputs should generate a shared http_server source receiving the events from the events watcher", func() {
+		exp, err := tomlContent.ReadFile("events.toml")
+		Expect(err).ToNot(HaveOccurred())
+		inputs := []obs.InputSpec{
+			{
+				Name:   "kube-events",
+				Type:   obs.InputTypeEvents,
+				Events: &obs.EventsInput{},
+			},
+			{
+				Name: "warning-events",
+				Type: obs.InputTypeEvents,
+				Events: &obs.EventsInput{
+					Type: obs.EventTypeWarning,
+				},
+			},
+		}
+		clf := obs.ClusterLogForwarder{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      constants.SingletonName,
+				Namespace: constants.OpenshiftNS,
+			},
+		}
+		conf := api.NewConfig(func(config *api.Config) {
+			for _, input := range inputs {
+				sources, transforms := NewSource(adapters.NewInput(input), *factory.ResourceNames(clf), secrets, framework.NoOptions)
+				config.AddSources(sources)
+				config.AddTransforms(transforms)
+			}
+		})
+		Expect(exp).To(EqualConfigFrom(conf))
+	})
 })
@@ -0,0 +1,38 @@
+package metrics
+
+import (
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	vectorhelpers "github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+)
+
+const (
+	// RejectedRequestReasonUnauthorized is the reason of requests rejected for a missing or invalid bearer token
+	RejectedRequestReasonUnauthorized = "unauthorized"
+)
+
+// NewRejectedRequests returns the transform counting the requests rejected by the auth program identified by authID
+// as the `logcollector_receiver_rejected_requests_count` metric, labeled by the input and the reason. The auth program
+// routes the requests it rejects to its dropped output
+func NewRejectedRequests(inputName, authID string, op utils.Options) types.Transform {
+	logToMetric := transforms.NewLogToMetric(
+		"receiver_rejected_requests_count",
+		transforms.MetricsTypeCounter,
+		transforms.Tags{
+			"input":  inputName,
+			"reason": RejectedRequestReasonUnauthorized,
+		},
+		vectorhelpers.MakeRouteInputID(authID, "dropped"),
+	)
+	// The request is dropped as received so only the fields added by the receiver are known to exist
+	logToMetric.Metrics[0].Field = "source_type"
+	op.AddToStringSet(framework.OptionLogsToMetricInputs, RejectedRequestsID(authID))
+	return logToMetric
+}
+
+// RejectedRequestsID returns the ID of the transform counting the requests rejected by the auth program identified by authID
+func RejectedRequestsID(authID string) string {
+	return vectorhelpers.MakeID(authID, "rejected")
+}
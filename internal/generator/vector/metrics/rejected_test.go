@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	"github.com/openshift/cluster-logging-operator/internal/utils/toml"
+	"github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("#NewRejectedRequests", func() {
+
+	It("should count the requests dropped by the auth program of the receiver", func() {
+		op := utils.Options{}
+		config := api.NewConfig(func(c *api.Config) {
+			c.Transforms[RejectedRequestsID("input_my_audit_auth")] = NewRejectedRequests("my-audit", "input_my_audit_auth", op)
+		})
+		Expect(toml.MustMarshal(config)).To(matchers.EqualTrimLines(`
+[transforms]
+[transforms.input_my_audit_auth_rejected]
+inputs = ["input_my_audit_auth.dropped"]
+type = "log_to_metric"
+
+[[transforms.input_my_audit_auth_rejected.metrics]]
+field = "source_type"
+kind = "incremental"
+name = "receiver_rejected_requests_count"
+namespace = "logcollector"
+tags = {input = "my-audit", reason = "unauthorized"}
+type = "counter"
+`))
+		Expect(op.GetStringSet(framework.OptionLogsToMetricInputs)).To(ConsistOf("input_my_audit_auth_rejected"))
+	})
+})
@@ -4,12 +4,12 @@ import (
 	_ "embed"
 	"fmt"
 
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/api/observability"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sinks"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
-	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/codec"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/common/tls"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/aws/auth"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
@@ -19,10 +19,22 @@ import (
 	vectorhelpers "github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 )
 
+const (
+	dailyPartition  = "year={@timestamp|year}/month={@timestamp|month}/day={@timestamp|day}/"
+	hourlyPartition = dailyPartition + "hour={@timestamp|hour}/"
+)
+
 func New(id string, o *adapters.Output, inputs []string, secrets observability.Secrets, op utils.Options) (_ string, sink types.Sink, tfs api.Transforms) {
 	keyPrefixID := vectorhelpers.MakeID(id, "key_prefix")
 	tfs = api.Transforms{}
-	tfs[keyPrefixID] = template.NewTemplateRemap(inputs, o.S3.KeyPrefix, keyPrefixID)
+	tfs[keyPrefixID] = template.NewTemplateRemap(inputs, keyPrefix(o.S3), keyPrefixID)
+	inputID := keyPrefixID
+
+	if o.S3.Encoding == obs.ObjectEncodingTypeText {
+		payloadID := vectorhelpers.MakeID(id, "payload")
+		tfs[payloadID] = common.NewPayloadRemap(o.S3.PayloadKey, inputID)
+		inputID = payloadID
+	}
 
 	sink = sinks.NewAwsS3(func(s *sinks.AwsS3) {
 		s.Region = o.S3.Region
@@ -30,13 +42,45 @@ func New(id string, o *adapters.Output, inputs []string, secrets observability.S
 		s.KeyPrefix = fmt.Sprintf("{{ _internal.%s }}", keyPrefixID)
 		s.Endpoint = o.S3.URL
 		s.Auth = auth.New(o.Name, o.S3.Authentication, op)
-		s.Encoding = common.NewApiEncoding(codec.CodecTypeJSON)
-		s.Batch = common.NewApiBatch(o)
+		encoding := common.NewObjectEncoding(o.S3.Encoding)
+		s.Encoding, s.Framing = encoding.Encoding, encoding.Framing
+		s.FilenameExtension, s.ContentType = encoding.FilenameExtension, encoding.ContentType
+		s.Batch = batch(o)
 		s.Compression = sinks.CompressionType(o.GetTuning().Compression)
 		s.Buffer = common.NewApiBuffer(o)
 		s.Request = common.NewApiRequest(o)
 		s.TLS = tls.NewTls(o, secrets, op)
-	}, keyPrefixID)
+	}, inputID)
 
 	return id, sink, tfs
 }
+
+// keyPrefix is the user defined key prefix followed by the date partition of the record
+func keyPrefix(s3 *obs.S3) string {
+	switch s3.KeyPartitioning {
+	case obs.S3KeyPartitioningDaily:
+		return s3.KeyPrefix + dailyPartition
+	case obs.S3KeyPartitioningHourly:
+		return s3.KeyPrefix + hourlyPartition
+	}
+	return s3.KeyPrefix
+}
+
+// batch adds the batch tuning of the S3 output to the batch derived from the max write
+func batch(o *adapters.Output) *sinks.Batch {
+	b := common.NewApiBatch(o)
+	t := o.S3.Tuning
+	if t == nil || (t.MaxEvents == nil && t.BatchTimeout == nil) {
+		return b
+	}
+	if b == nil {
+		b = &sinks.Batch{}
+	}
+	if t.MaxEvents != nil && *t.MaxEvents > 0 {
+		b.MaxEvents = uint(*t.MaxEvents)
+	}
+	if t.BatchTimeout != nil && t.BatchTimeout.Duration > 0 {
+		b.TimeoutSec = t.BatchTimeout.Seconds()
+	}
+	return b
+}
@@ -2,6 +2,7 @@ package s3_test
 
 import (
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -13,6 +14,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/aws/s3"
 	. "github.com/openshift/cluster-logging-operator/test/matchers"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var _ = Describe("Generating vector config for s3 output", func() {
@@ -189,6 +191,27 @@ var _ = Describe("Generating vector config for s3 output", func() {
 				spec.S3.KeyPrefix = "app-{.log_type||\"missing\"}"
 				spec.S3.URL = "http://mylogreceiver"
 			}, framework.NoOptions, "files/s3_with_url.toml"),
+
+			Entry("when hourly key partitioning is spec'd", func(spec *obs.OutputSpec) {
+				spec.S3.KeyPrefix = "{.log_type||\"missing\"}/"
+				spec.S3.KeyPartitioning = obs.S3KeyPartitioningHourly
+			}, framework.NoOptions, "files/s3_with_hourly_partitioning.toml"),
+
+			Entry("when ndjson encoding and batch tuning are spec'd", func(spec *obs.OutputSpec) {
+				maxEvents := int64(5000)
+				spec.S3.KeyPrefix = "app-{.log_type||\"missing\"}"
+				spec.S3.Encoding = obs.ObjectEncodingTypeNDJSON
+				spec.S3.Tuning = &obs.S3TuningSpec{
+					MaxEvents:    &maxEvents,
+					BatchTimeout: &metav1.Duration{Duration: 90 * time.Second},
+				}
+			}, framework.NoOptions, "files/s3_with_ndjson_and_batch.toml"),
+
+			Entry("when text encoding with a payloadKey is spec'd", func(spec *obs.OutputSpec) {
+				spec.S3.KeyPrefix = "app-{.log_type||\"missing\"}"
+				spec.S3.Encoding = obs.ObjectEncodingTypeText
+				spec.S3.PayloadKey = `.kubernetes.labels."app.kubernetes.io/name"`
+			}, framework.NoOptions, "files/s3_with_text_payload_key.toml"),
 		)
 	})
 })
@@ -0,0 +1,52 @@
+package azureblob
+
+import (
+	"fmt"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sinks"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/common/tls"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/azure/azurelogsingestion"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/template"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+
+	vectorhelpers "github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+func New(id string, o *adapters.Output, inputs []string, secrets observability.Secrets, op utils.Options) (_ string, sink types.Sink, tfs api.Transforms) {
+	blob := o.AzureBlob
+	keyPrefixID := vectorhelpers.MakeID(id, "key_prefix")
+	tfs = api.Transforms{}
+	tfs[keyPrefixID] = template.NewTemplateRemap(inputs, blob.KeyPrefix, keyPrefixID)
+	inputID := keyPrefixID
+
+	if blob.Encoding == obs.ObjectEncodingTypeText {
+		payloadID := vectorhelpers.MakeID(id, "payload")
+		tfs[payloadID] = common.NewPayloadRemap(blob.PayloadKey, inputID)
+		inputID = payloadID
+	}
+
+	sink = sinks.NewAzureBlob(func(s *sinks.AzureBlob) {
+		s.StorageAccount = blob.StorageAccount
+		s.ContainerName = blob.Container
+		s.BlobPrefix = fmt.Sprintf("{{ _internal.%s }}", keyPrefixID)
+		s.Endpoint = blob.URL
+		if blob.Authentication != nil {
+			s.Auth = azurelogsingestion.NewAuth(blob.Authentication)
+		}
+		encoding := common.NewObjectEncoding(blob.Encoding)
+		s.Encoding, s.Framing = encoding.Encoding, encoding.Framing
+		s.Batch = common.NewApiBatch(o)
+		s.Compression = sinks.CompressionType(o.GetTuning().Compression)
+		s.Buffer = common.NewApiBuffer(o)
+		s.Request = common.NewApiRequest(o)
+		s.TLS = tls.NewTls(o, secrets, op)
+	}, inputID)
+
+	return id, sink, tfs
+}
@@ -0,0 +1,102 @@
+package azureblob_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/azure/azureblob"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("Generating vector config for Azure Blob Storage output", func() {
+
+	const (
+		secretName      = "azure-blob-secret"
+		clientSecretKey = "client_secret"
+		tenantId        = "a0b1c2d3-e4f5-a6b7-c8d9-e0f1a2b3c4d5"
+		clientId        = "b1c2d3e4-f5a6-b7c8-d9e0-f1a2b3c4d5e6"
+	)
+
+	var (
+		initOutput = func() obs.OutputSpec {
+			return obs.OutputSpec{
+				Type: obs.OutputTypeAzureBlob,
+				Name: "azure-blob",
+				AzureBlob: &obs.AzureBlob{
+					StorageAccount: "mylogsaccount",
+					Container:      "cluster-logs",
+					KeyPrefix:      `{.log_type||"missing"}/`,
+					Authentication: &obs.AzureLogsIngestionAuthentication{
+						Type: obs.AzureLogsIngestionAuthTypeClientSecret,
+						ClientSecret: &obs.AzureLogsIngestionClientSecret{
+							TenantId: tenantId,
+							ClientId: clientId,
+							Secret: &obs.SecretReference{
+								Key:        clientSecretKey,
+								SecretName: secretName,
+							},
+						},
+					},
+				},
+			}
+		}
+
+		secrets = map[string]*corev1.Secret{
+			secretName: {
+				Data: map[string][]byte{
+					clientSecretKey: []byte("AbCdE~FgH1IjKlMnOpQrStUvWxYz0123456789Ab"),
+				},
+			},
+		}
+	)
+
+	DescribeTable("should generate valid config", func(visit func(spec *obs.OutputSpec), expFile string) {
+		exp, err := testFiles.ReadFile(expFile)
+		if err != nil {
+			Fail(fmt.Sprintf("Error reading the file %q with exp config: %v", expFile, err))
+		}
+		outputSpec := initOutput()
+		if visit != nil {
+			visit(&outputSpec)
+		}
+		op := framework.Options{framework.OptionForwarderName: "my-forwarder"}
+		id, sink, transforms := azureblob.New(outputSpec.Name, adapters.NewOutput(outputSpec), []string{"azure-blob-forward"}, secrets, op)
+		Expect(exp).To(EqualConfigFrom(api.NewConfig(func(c *api.Config) {
+			c.Sinks[id] = sink
+			c.AddTransforms(transforms)
+		})))
+	},
+		Entry("with client secret authentication", nil, "files/azureblob_with_client_secret.toml"),
+
+		Entry("with workload identity authentication", func(spec *obs.OutputSpec) {
+			spec.AzureBlob.Authentication = &obs.AzureLogsIngestionAuthentication{
+				Type: obs.AzureLogsIngestionAuthTypeWorkloadIdentity,
+				WorkloadIdentity: &obs.AzureLogsIngestionWorkloadIdentity{
+					TenantId: tenantId,
+					ClientId: clientId,
+					Token: &obs.BearerToken{
+						From: obs.BearerTokenFromServiceAccount,
+					},
+				},
+			}
+		}, "files/azureblob_with_workload_identity.toml"),
+
+		Entry("with custom endpoint, ndjson encoding and compression", func(spec *obs.OutputSpec) {
+			spec.AzureBlob.URL = "https://mylogsaccount.blob.core.usgovcloudapi.net"
+			spec.AzureBlob.Encoding = obs.ObjectEncodingTypeNDJSON
+			spec.AzureBlob.Tuning = &obs.AzureBlobTuningSpec{
+				Compression: "zstd",
+			}
+		}, "files/azureblob_with_ndjson_and_compression.toml"),
+
+		Entry("with text encoding", func(spec *obs.OutputSpec) {
+			spec.AzureBlob.Encoding = obs.ObjectEncodingTypeText
+		}, "files/azureblob_with_text.toml"),
+	)
+})
@@ -38,9 +38,12 @@ func auth(s *sinks.AzureLogsIngestion, azli *obs.AzureLogsIngestion) {
 	if azli == nil || azli.Authentication == nil {
 		return
 	}
+	s.Auth = NewAuth(azli.Authentication)
+}
 
+// NewAuth returns the Azure AD credentials of the sink for the given authentication spec
+func NewAuth(azliAuth *obs.AzureLogsIngestionAuthentication) *sinks.AzureLogsIngestionAuth {
 	auth := &sinks.AzureLogsIngestionAuth{}
-	azliAuth := azli.Authentication
 
 	// Vector uses different field names for workload identity vs client secret auth
 	switch azliAuth.Type {
@@ -71,8 +74,7 @@ func auth(s *sinks.AzureLogsIngestion, azli *obs.AzureLogsIngestion) {
 			}
 		}
 	}
-
-	s.Auth = auth
+	return auth
 }
 
 func New(id string, o *adapters.Output, inputs []string, secrets observability.Secrets, op utils.Options) (_ string, sink types.Sink, tfs api.Transforms) {
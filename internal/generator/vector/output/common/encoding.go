@@ -1,10 +1,20 @@
 package common
 
 import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sinks"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/codec"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 )
 
+const payloadTmpl = `payload = get!(., %s)
+.message = to_string(payload) ?? encode_json(payload)`
+
 func NewApiEncoding(codecType codec.CodecType) (e *sinks.Encoding) {
 	e = &sinks.Encoding{
 		Codec:        codecType,
@@ -12,3 +22,43 @@ func NewApiEncoding(codecType codec.CodecType) (e *sinks.Encoding) {
 	}
 	return e
 }
+
+// ObjectEncoding is how the records are written to the objects of the object storage outputs
+type ObjectEncoding struct {
+	Encoding          *sinks.Encoding
+	Framing           *sinks.Framing
+	FilenameExtension string
+	ContentType       string
+}
+
+// NewObjectEncoding returns the encoding and framing of the objects written by the object storage outputs, and the
+// filename extension and content type of the encodings that are not the default of the collector
+func NewObjectEncoding(encoding obs.ObjectEncodingType) ObjectEncoding {
+	switch encoding {
+	case obs.ObjectEncodingTypeNDJSON:
+		return ObjectEncoding{
+			Encoding:          NewApiEncoding(codec.CodecTypeJSON),
+			Framing:           &sinks.Framing{Method: sinks.FramingMethodNewlineDelimited},
+			FilenameExtension: "ndjson",
+			ContentType:       "application/x-ndjson",
+		}
+	case obs.ObjectEncodingTypeText:
+		return ObjectEncoding{
+			Encoding:          &sinks.Encoding{Codec: codec.CodecTypeText},
+			Framing:           &sinks.Framing{Method: sinks.FramingMethodNewlineDelimited},
+			FilenameExtension: "log",
+			ContentType:       "text/plain",
+		}
+	}
+	return ObjectEncoding{Encoding: NewApiEncoding(codec.CodecTypeJSON)}
+}
+
+// NewPayloadRemap sets the message of the record to the value of the payload key, which is the only
+// field written by the text codec. The message is used when no payload key is given
+func NewPayloadRemap(payloadKey obs.FieldPath, inputs ...string) types.Transform {
+	if payloadKey == "" {
+		payloadKey = ".message"
+	}
+	path := helpers.QuotePathSegments(helpers.SplitPath(string(payloadKey)))
+	return transforms.NewRemap(fmt.Sprintf(payloadTmpl, fmt.Sprintf("[%s]", strings.Join(path, ","))), inputs...)
+}
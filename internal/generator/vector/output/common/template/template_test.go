@@ -16,5 +16,8 @@ var _ = Describe("Vector Output Template", func() {
 
 		Entry("should only add quotes and not transform template if using only a static value", `"foobar-myindex"`, `foobar-myindex`),
 		Entry("should transform template if only a dynamic value is defined", `to_string!(._internal.foo.bar||"missing")`, `{.foo.bar||"missing"}`),
+		Entry("should transform timestamp patterns using the timestamp of the record",
+			`"logs/" + to_string!(._internal.log_type||"none") + "/year=" + format_timestamp!(.timestamp || now(), format: "%Y") + "/month=" + format_timestamp!(.timestamp || now(), format: "%m") + "/day=" + format_timestamp!(.timestamp || now(), format: "%d") + "/hour=" + format_timestamp!(.timestamp || now(), format: "%H") + "/"`,
+			`logs/{.log_type||"none"}/year={@timestamp|year}/month={@timestamp|month}/day={@timestamp|day}/hour={@timestamp|hour}/`),
 	)
 })
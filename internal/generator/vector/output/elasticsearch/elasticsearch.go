@@ -2,6 +2,7 @@ package elasticsearch
 
 import (
 	"fmt"
+	"strings"
 
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/api/observability"
@@ -22,7 +23,7 @@ import (
 func New(id string, o *adapters.Output, inputs []string, secrets observability.Secrets, op utils.Options) (_ string, sink types.Sink, tfs api.Transforms) {
 	componentID := helpers.MakeID(id, "index")
 	tfs = api.Transforms{}
-	if o.Elasticsearch.Version == 6 {
+	if o.Elasticsearch.Version == 6 && o.Elasticsearch.DocumentID == "" {
 		addID := helpers.MakeID(id, "add_id")
 		tfs[addID] = transforms.NewRemap(`._id = encode_base64(uuid_v4())
 if exists(.kubernetes.event.metadata.uid) {
@@ -30,12 +31,33 @@ if exists(.kubernetes.event.metadata.uid) {
 }`, inputs...)
 		inputs = []string{addID}
 	}
-	tfs[componentID] = commontemplate.NewTemplateRemap(inputs, o.Elasticsearch.Index, componentID)
+	documentID := ""
+	if o.Elasticsearch.DocumentID != "" {
+		documentID = helpers.MakeID(id, "document_id")
+		tfs[documentID] = commontemplate.NewTemplateRemap(inputs, o.Elasticsearch.DocumentID, documentID)
+		inputs = []string{documentID}
+	}
+	if o.Elasticsearch.DataStream != nil {
+		componentID = helpers.MakeID(id, "data_stream")
+		tfs[componentID] = dataStreamRemap(o.Elasticsearch.DataStream, componentID, inputs...)
+	} else {
+		tfs[componentID] = commontemplate.NewTemplateRemap(inputs, o.Elasticsearch.Index, componentID)
+	}
 	sink = sinks.NewElasticsearch(o.Elasticsearch.URL, func(s *sinks.Elasticsearch) {
-		s.Bulk = &sinks.Bulk{
-			Action: sinks.BulkActionCreate,
-			Index:  fmt.Sprintf("{{ _internal.%s }}", componentID),
+		if o.Elasticsearch.DataStream != nil {
+			// Data streams only accept the create action
+			s.Mode = sinks.ElasticsearchModeDataStream
+			s.Bulk = &sinks.Bulk{
+				Action: sinks.BulkActionCreate,
+			}
+			s.DataStream = dataStream(o.Elasticsearch.DataStream, componentID)
+		} else {
+			s.Bulk = &sinks.Bulk{
+				Action: bulkAction(o.Elasticsearch.BulkAction),
+				Index:  fmt.Sprintf("{{ _internal.%s }}", componentID),
+			}
 		}
+		s.Pipeline = o.Elasticsearch.Pipeline
 		s.ApiVersion = apiVersionFrom(o.Elasticsearch.Version)
 		s.Encoding = common.NewApiEncoding("")
 		s.Compression = sinks.CompressionType(o.GetTuning().Compression)
@@ -49,7 +71,10 @@ if exists(.kubernetes.event.metadata.uid) {
 			s.Request.Headers = o.Elasticsearch.Headers
 		}
 		elasticsearchAuth(s, o, op)
-		if o.Elasticsearch.Version == 6 {
+		switch {
+		case documentID != "":
+			s.IdKey = fmt.Sprintf("_internal.%s", documentID)
+		case o.Elasticsearch.Version == 6:
 			s.IdKey = "_id"
 		}
 		s.TLS = tls.NewTls(o, secrets, op)
@@ -57,6 +82,43 @@ if exists(.kubernetes.event.metadata.uid) {
 	return id, sink, tfs
 }
 
+func bulkAction(action obs.ElasticsearchBulkAction) sinks.BulkActionType {
+	if action == obs.ElasticsearchBulkActionIndex {
+		return sinks.BulkActionIndex
+	}
+	return sinks.BulkActionCreate
+}
+
+// dataStreamRemap evaluates the templates of the data stream. The data stream fields which are not
+// set are defaulted by the collector
+func dataStreamRemap(spec *obs.ElasticsearchDataStream, field string, inputs ...string) types.Transform {
+	lines := []string{fmt.Sprintf("._internal.%s = {}", field)}
+	for _, f := range []struct{ name, template string }{
+		{"type", spec.Type},
+		{"dataset", spec.Dataset},
+		{"namespace", spec.Namespace},
+	} {
+		if f.template != "" {
+			lines = append(lines, fmt.Sprintf("._internal.%s.%s = %s", field, f.name, commontemplate.TransformUserTemplateToVRL(f.template)))
+		}
+	}
+	return transforms.NewRemap(strings.Join(lines, "\n"), inputs...)
+}
+
+func dataStream(spec *obs.ElasticsearchDataStream, field string) *sinks.DataStream {
+	ds := &sinks.DataStream{}
+	if spec.Type != "" {
+		ds.Type = fmt.Sprintf("{{ _internal.%s.type }}", field)
+	}
+	if spec.Dataset != "" {
+		ds.Dataset = fmt.Sprintf("{{ _internal.%s.dataset }}", field)
+	}
+	if spec.Namespace != "" {
+		ds.Namespace = fmt.Sprintf("{{ _internal.%s.namespace }}", field)
+	}
+	return ds
+}
+
 func apiVersionFrom(version int) sinks.ElasticsearchApiVersion {
 	switch version {
 	case 6:
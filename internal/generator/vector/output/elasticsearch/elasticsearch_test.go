@@ -159,5 +159,24 @@ var _ = Describe("Generate Vector config", func() {
 				"Key": "Value",
 			}
 		}, true, framework.NoOptions, "es_with_headers.toml"),
+		Entry("with data stream and ingest pipeline", func(spec *obs.OutputSpec) {
+			spec.Elasticsearch.Authentication = nil
+			spec.Elasticsearch.Index = ""
+			spec.Elasticsearch.DataStream = &obs.ElasticsearchDataStream{
+				Dataset:   `{.log_type||"none"}`,
+				Namespace: `{.kubernetes.namespace_name||"none"}`,
+			}
+			spec.Elasticsearch.Pipeline = "logs-pipeline"
+		}, false, framework.NoOptions, "es_with_data_stream.toml"),
+		Entry("with document ID and index action", func(spec *obs.OutputSpec) {
+			spec.Elasticsearch.Authentication = nil
+			spec.Elasticsearch.BulkAction = obs.ElasticsearchBulkActionIndex
+			spec.Elasticsearch.DocumentID = `{.kubernetes.pod_id||"none"}-{.timestamp||"none"}`
+		}, false, framework.NoOptions, "es_with_document_id.toml"),
+		Entry("with document ID for version 6", func(spec *obs.OutputSpec) {
+			spec.Elasticsearch.Authentication = nil
+			spec.Elasticsearch.Version = 6
+			spec.Elasticsearch.DocumentID = `{.kubernetes.pod_id||"none"}-{.timestamp||"none"}`
+		}, false, framework.NoOptions, "es_with_document_id_v6.toml"),
 	)
 })
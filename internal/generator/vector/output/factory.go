@@ -11,10 +11,12 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/aws/cloudwatch"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/aws/s3"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/azure/azureblob"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/azure/azurelogsingestion"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/azure/azuremonitor"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/elasticsearch"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/gcl"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/gcs"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/http"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/kafka"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/loki"
@@ -58,12 +60,16 @@ func New(o *adapters.Output, inputs []string, secrets map[string]*corev1.Secret,
 		sinkId, sink, sinkTransforms = s3.New(baseID, o, inputs, secrets, op)
 	case obs.OutputTypeGoogleCloudLogging:
 		sinkId, sink, sinkTransforms = gcl.New(baseID, o, inputs, secrets, op)
+	case obs.OutputTypeGoogleCloudStorage:
+		sinkId, sink, sinkTransforms = gcs.New(baseID, o, inputs, secrets, op)
 	case obs.OutputTypeSplunk:
 		sinkId, sink, sinkTransforms = splunk.New(baseID, o, inputs, secrets, op)
 	case obs.OutputTypeHTTP:
 		sinkId, sink, sinkTransforms = http.New(baseID, o, inputs, secrets, op)
 	case obs.OutputTypeSyslog:
 		sinkId, sink, sinkTransforms = syslog.New(baseID, o, inputs, secrets, op)
+	case obs.OutputTypeAzureBlob:
+		sinkId, sink, sinkTransforms = azureblob.New(baseID, o, inputs, secrets, op)
 	case obs.OutputTypeAzureLogsIngestion:
 		sinkId, sink, sinkTransforms = azurelogsingestion.New(baseID, o, inputs, secrets, op)
 	case obs.OutputTypeAzureMonitor:
@@ -36,7 +36,7 @@ func New(id string, o *adapters.Output, inputs []string, secrets observability.S
 		LogDestination(s, o.GoogleCloudLogging)
 		s.LogId = fmt.Sprintf("{{ _internal.%s }}", componentID)
 		s.SeverityKey = DefaultSeverityKey
-		s.CredentialsPath = auth(g.Authentication)
+		s.CredentialsPath = CredentialsPath(g.Authentication)
 		s.Encoding = common.NewApiEncoding("")
 		s.Batch = common.NewApiBatch(o)
 		s.Buffer = common.NewApiBuffer(o)
@@ -50,7 +50,8 @@ func New(id string, o *adapters.Output, inputs []string, secrets observability.S
 	return id, sink, tfs
 }
 
-func auth(spec *obs.GoogleCloudLoggingAuthentication) string {
+// CredentialsPath is the path of the mounted GCP credentials file of the given authentication spec
+func CredentialsPath(spec *obs.GoogleCloudLoggingAuthentication) string {
 	if spec == nil || spec.Credentials == nil {
 		return ""
 	}
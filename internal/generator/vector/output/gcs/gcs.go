@@ -0,0 +1,50 @@
+package gcs
+
+import (
+	"fmt"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sinks"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/common/tls"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/template"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/gcl"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+
+	vectorhelpers "github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+func New(id string, o *adapters.Output, inputs []string, secrets observability.Secrets, op utils.Options) (_ string, sink types.Sink, tfs api.Transforms) {
+	g := o.GoogleCloudStorage
+	keyPrefixID := vectorhelpers.MakeID(id, "key_prefix")
+	tfs = api.Transforms{}
+	tfs[keyPrefixID] = template.NewTemplateRemap(inputs, g.KeyPrefix, keyPrefixID)
+	inputID := keyPrefixID
+
+	if g.Encoding == obs.ObjectEncodingTypeText {
+		payloadID := vectorhelpers.MakeID(id, "payload")
+		tfs[payloadID] = common.NewPayloadRemap(g.PayloadKey, inputID)
+		inputID = payloadID
+	}
+
+	sink = sinks.NewGcpCloudStorage(func(s *sinks.GcpCloudStorage) {
+		s.Bucket = g.Bucket
+		s.KeyPrefix = fmt.Sprintf("{{ _internal.%s }}", keyPrefixID)
+		s.Endpoint = g.URL
+		s.CredentialsPath = gcl.CredentialsPath(g.Authentication)
+		encoding := common.NewObjectEncoding(g.Encoding)
+		s.Encoding, s.Framing = encoding.Encoding, encoding.Framing
+		s.FilenameExtension, s.ContentType = encoding.FilenameExtension, encoding.ContentType
+		s.Batch = common.NewApiBatch(o)
+		s.Compression = sinks.CompressionType(o.GetTuning().Compression)
+		s.Buffer = common.NewApiBuffer(o)
+		s.Request = common.NewApiRequest(o)
+		s.TLS = tls.NewTls(o, secrets, op)
+	}, inputID)
+
+	return id, sink, tfs
+}
@@ -0,0 +1,80 @@
+package gcs_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/gcl"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/gcs"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("Generating vector config for Google Cloud Storage output", func() {
+
+	const secretName = "gcs-secret"
+
+	var (
+		initOutput = func() obs.OutputSpec {
+			return obs.OutputSpec{
+				Type: obs.OutputTypeGoogleCloudStorage,
+				Name: "gcs",
+				GoogleCloudStorage: &obs.GoogleCloudStorage{
+					Bucket:    "my-log-bucket",
+					KeyPrefix: `{.log_type||"missing"}/`,
+					Authentication: &obs.GoogleCloudLoggingAuthentication{
+						Credentials: &obs.SecretReference{
+							Key:        gcl.GoogleApplicationCredentialsKey,
+							SecretName: secretName,
+						},
+					},
+				},
+			}
+		}
+
+		secrets = map[string]*corev1.Secret{
+			secretName: {
+				Data: map[string][]byte{
+					gcl.GoogleApplicationCredentialsKey: []byte(`{"type":"service_account"}`),
+				},
+			},
+		}
+	)
+
+	DescribeTable("should generate valid config", func(visit func(spec *obs.OutputSpec), expFile string) {
+		exp, err := testFiles.ReadFile(expFile)
+		if err != nil {
+			Fail(fmt.Sprintf("Error reading the file %q with exp config: %v", expFile, err))
+		}
+		outputSpec := initOutput()
+		if visit != nil {
+			visit(&outputSpec)
+		}
+		op := framework.Options{framework.OptionForwarderName: "my-forwarder"}
+		id, sink, transforms := gcs.New(outputSpec.Name, adapters.NewOutput(outputSpec), []string{"gcs-forward"}, secrets, op)
+		Expect(exp).To(EqualConfigFrom(api.NewConfig(func(c *api.Config) {
+			c.Sinks[id] = sink
+			c.AddTransforms(transforms)
+		})))
+	},
+		Entry("with credentials", nil, "files/gcs_with_credentials.toml"),
+
+		Entry("with custom endpoint, ndjson encoding and compression", func(spec *obs.OutputSpec) {
+			spec.GoogleCloudStorage.URL = "https://storage-psc.p.googleapis.com"
+			spec.GoogleCloudStorage.Encoding = obs.ObjectEncodingTypeNDJSON
+			spec.GoogleCloudStorage.Tuning = &obs.GoogleCloudStorageTuningSpec{
+				Compression: "gzip",
+			}
+		}, "files/gcs_with_ndjson_and_compression.toml"),
+
+		Entry("with text encoding and a payloadKey", func(spec *obs.OutputSpec) {
+			spec.GoogleCloudStorage.Encoding = obs.ObjectEncodingTypeText
+			spec.GoogleCloudStorage.PayloadKey = ".structured"
+		}, "files/gcs_with_text_payload_key.toml"),
+	)
+})
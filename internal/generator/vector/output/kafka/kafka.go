@@ -2,7 +2,9 @@ package kafka
 
 import (
 	"fmt"
+	"maps"
 	"net/url"
+	"slices"
 	"strings"
 
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
@@ -12,6 +14,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sinks"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/codec"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/common/tls"
@@ -26,6 +29,8 @@ const (
 	SASLMechanismPlain = "PLAIN"
 	SASL_SCRAM_512     = "SCRAM-SHA-512"
 	SASL_SCRAM_256     = "SCRAM-SHA-256"
+
+	headerTmpl = `  %s: to_string(%[2]s) ?? encode_json(%[2]s),`
 )
 
 func New(id string, o *adapters.Output, inputs []string, secrets observability.Secrets, op utils.Options) (string, types.Sink, api.Transforms) {
@@ -33,9 +38,31 @@ func New(id string, o *adapters.Output, inputs []string, secrets observability.S
 	tfs := api.Transforms{
 		componentID: commontemplate.NewTemplateRemap(inputs, topic(o.Kafka), componentID),
 	}
+	inputID := componentID
+
+	keyID := ""
+	if o.Kafka.Key != "" {
+		keyID = vectorhelpers.MakeID(id, "key")
+		tfs[keyID] = commontemplate.NewTemplateRemap([]string{inputID}, o.Kafka.Key, keyID)
+		inputID = keyID
+	}
+
+	headersID := ""
+	if len(o.Kafka.Headers) > 0 {
+		headersID = vectorhelpers.MakeID(id, "headers")
+		tfs[headersID] = headers(o.Kafka.Headers, headersID, inputID)
+		inputID = headersID
+	}
+
 	sink := sinks.NewKafka(func(s *sinks.Kafka) {
 		s.BootstrapServers = brokers(o.Kafka)
 		s.Topic = fmt.Sprintf("{{ _internal.%s }}", componentID)
+		if keyID != "" {
+			s.KeyField = fmt.Sprintf("._internal.%s", keyID)
+		}
+		if headersID != "" {
+			s.HeadersKey = fmt.Sprintf("._internal.%s", headersID)
+		}
 		s.Compression = sinks.CompressionType(o.GetTuning().Compression)
 		s.Encoding = common.NewApiEncoding(codec.CodecTypeJSON)
 		s.Encoding.TimestampFormat = "rfc3339"
@@ -47,7 +74,7 @@ func New(id string, o *adapters.Output, inputs []string, secrets observability.S
 		}
 		sasl(s, o.Kafka.Authentication)
 		librdKafkaOptions(s, o)
-	}, componentID)
+	}, inputID)
 	return id, sink, tfs
 }
 
@@ -66,11 +93,36 @@ func librdKafkaOptions(s *sinks.Kafka, o *adapters.Output) {
 	if o.TLS != nil && isTlsBrokers(o.Kafka) && o.TLS.InsecureSkipVerify {
 		s.LibrdKafka_Options["enable.ssl.certificate.verification"] = "false"
 	}
+	if o.Kafka.Tuning != nil {
+		for name, value := range o.Kafka.Tuning.LibrdKafkaOptions {
+			s.LibrdKafka_Options[name] = value
+		}
+	}
+	if oauth := oauthAuthentication(o.Kafka); oauth != nil {
+		s.LibrdKafka_Options["sasl.oauthbearer.method"] = "oidc"
+		s.LibrdKafka_Options["sasl.oauthbearer.token.endpoint.url"] = oauth.TokenURL
+		s.LibrdKafka_Options["sasl.oauthbearer.client.id"] = vectorhelpers.SecretFrom(oauth.ClientID)
+		s.LibrdKafka_Options["sasl.oauthbearer.client.secret"] = vectorhelpers.SecretFrom(oauth.ClientSecret)
+		if oauth.Scope != "" {
+			s.LibrdKafka_Options["sasl.oauthbearer.scope"] = oauth.Scope
+		}
+	}
 	if o.GetTuning() != nil && o.GetTuning().MaxWrite != nil {
 		s.LibrdKafka_Options["message.max.bytes"] = fmt.Sprintf("%v", o.GetTuning().MaxWrite.Value())
 	}
 }
 
+// headers returns the remap adding the map of the kafka headers to the record. Fields which do not exist
+// are dropped from the map
+func headers(spec map[string]obs.FieldPath, field string, inputs ...string) types.Transform {
+	lines := []string{fmt.Sprintf("._internal.%s = compact({", field)}
+	for _, name := range slices.Sorted(maps.Keys(spec)) {
+		lines = append(lines, fmt.Sprintf(headerTmpl, vectorhelpers.VRLString(name), spec[name]))
+	}
+	lines = append(lines, "})")
+	return transforms.NewRemap(strings.Join(lines, "\n"), inputs...)
+}
+
 func isTlsBrokers(o *obs.Kafka) bool {
 	isTls := true
 	if o != nil {
@@ -85,20 +137,36 @@ func isTlsBrokers(o *obs.Kafka) bool {
 }
 
 func sasl(s *sinks.Kafka, spec *obs.KafkaAuthentication) {
-	if spec != nil {
-		saslAuth := spec.SASL
-		if saslAuth != nil && saslAuth.Username != nil && saslAuth.Password != nil {
-			s.Sasl = &sinks.Sasl{
-				Enabled:   true,
-				Username:  vectorhelpers.SecretFrom(saslAuth.Username),
-				Password:  vectorhelpers.SecretFrom(saslAuth.Password),
-				Mechanism: SASLMechanismPlain,
-			}
-			if saslAuth.Mechanism != "" {
-				s.Sasl.Mechanism = saslAuth.Mechanism
-			}
+	if spec == nil || spec.SASL == nil {
+		return
+	}
+	saslAuth := spec.SASL
+	switch {
+	case saslAuth.OAuth != nil:
+		// The token is fetched by librdkafka using the oidc method of the librdkafka options
+		s.Sasl = &sinks.Sasl{
+			Enabled:   true,
+			Mechanism: observability.KafkaSASLMechanismOAuthBearer,
 		}
+	case saslAuth.Username != nil && saslAuth.Password != nil:
+		s.Sasl = &sinks.Sasl{
+			Enabled:   true,
+			Username:  vectorhelpers.SecretFrom(saslAuth.Username),
+			Password:  vectorhelpers.SecretFrom(saslAuth.Password),
+			Mechanism: SASLMechanismPlain,
+		}
+		if saslAuth.Mechanism != "" {
+			s.Sasl.Mechanism = saslAuth.Mechanism
+		}
+	}
+}
+
+// oauthAuthentication returns the SASL/OAUTHBEARER authentication of a Kafka output, if present
+func oauthAuthentication(o *obs.Kafka) *obs.SASLOAuthentication {
+	if o != nil && o.Authentication != nil && o.Authentication.SASL != nil {
+		return o.Authentication.SASL.OAuth
 	}
+	return nil
 }
 
 // brokers returns the list of broker endpoints of a Kafka cluster.
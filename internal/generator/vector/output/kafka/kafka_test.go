@@ -129,6 +129,41 @@ var _ = Describe("Generate vector config", func() {
 				MaxWrite:     utils.GetPtr(resource.MustParse("10M")),
 			}
 		}),
+		Entry("with key and headers", "kafka_key_and_headers.toml", framework.NoOptions, func(spec *obs.OutputSpec) {
+			spec.Kafka.URL = "tcp://broker1-kafka.svc.messaging.cluster.local:9092"
+			spec.Kafka.Key = `{.kubernetes.namespace_name||"none"}/{.kubernetes.pod_name||"none"}`
+			spec.Kafka.Headers = map[string]obs.FieldPath{
+				"namespace": ".kubernetes.namespace_name",
+				"app":       `.kubernetes.labels."app.kubernetes.io/name"`,
+			}
+		}),
+		Entry("with librdkafka options", "kafka_librdkafka_options.toml", framework.NoOptions, func(spec *obs.OutputSpec) {
+			spec.Kafka.URL = "tcp://broker1-kafka.svc.messaging.cluster.local:9092/topic"
+			spec.Kafka.Topic = ""
+			spec.Kafka.Tuning = &obs.KafkaTuningSpec{
+				DeliveryMode: obs.DeliveryModeAtLeastOnce,
+				MaxWrite:     utils.GetPtr(resource.MustParse("10M")),
+				LibrdKafkaOptions: map[string]string{
+					"acks":       "all",
+					"linger.ms":  "50",
+					"batch.size": "1000000",
+				},
+			}
+		}),
+		Entry("with oauth sasl", "kafka_sasl_oauth.toml", framework.NoOptions, func(spec *obs.OutputSpec) {
+			spec.Kafka.URL = "tls://broker1-kafka.svc.messaging.cluster.local:9093/topic"
+			spec.Kafka.Topic = ""
+			spec.Kafka.Authentication = &obs.KafkaAuthentication{
+				SASL: &obs.SASLAuthentication{
+					OAuth: &obs.SASLOAuthentication{
+						TokenURL:     "https://idp.example.com/oauth2/token",
+						ClientID:     &obs.SecretReference{Key: "client_id", SecretName: secretName},
+						ClientSecret: &obs.SecretReference{Key: "client_secret", SecretName: secretName},
+						Scope:        "kafka",
+					},
+				},
+			}
+		}),
 		Entry("with tls sasl, with SCRAM-SHA-256 mechanism to single topic and custom CA", "kafka_sasl_ca_with_tls.toml", framework.NoOptions, func(spec *obs.OutputSpec) {
 			spec.Kafka.URL = "tls://broker1-kafka.svc.messaging.cluster.local:9092/mytopic"
 			spec.Kafka.Topic = "topic"
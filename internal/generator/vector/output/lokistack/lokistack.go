@@ -72,15 +72,22 @@ func determineTenants(inputSpecs []obs.InputSpec) *sets.String {
 		case obs.InputTypeInfrastructure:
 			tenants.Insert(string(obs.InputTypeInfrastructure))
 		case obs.InputTypeReceiver:
-			tenants.Insert(getTenantForReceiver(inputSpec.Receiver.Type))
+			tenants.Insert(getTenantForReceiver(inputSpec))
+		case obs.InputTypeKafka:
+			tenants.Insert(string(obs.InputTypeApplication))
+		case obs.InputTypeHostFile, obs.InputTypeEvents:
+			tenants.Insert(string(obs.InputTypeInfrastructure))
 		}
 	}
 
 	return tenants
 }
 
-func getTenantForReceiver(receiverType obs.ReceiverType) string {
-	if receiverType == obs.ReceiverTypeHTTP {
+func getTenantForReceiver(inputSpec obs.InputSpec) string {
+	if observability.IsApplicationReceiver(inputSpec) {
+		return string(obs.InputTypeApplication)
+	}
+	if inputSpec.Receiver.Type == obs.ReceiverTypeHTTP {
 		return string(obs.InputTypeAudit)
 	}
 	return string(obs.InputTypeInfrastructure)
@@ -126,7 +133,7 @@ func addReceiverSources(inputSources *[]string, inputSpecs []obs.InputSpec, inpu
 			continue
 		}
 
-		if inputType == obs.InputTypeAudit && is.Receiver.Type == obs.ReceiverTypeHTTP {
+		if inputType == obs.InputTypeAudit && is.Receiver.Type == obs.ReceiverTypeHTTP && !observability.IsApplicationReceiver(is) {
 			*inputSources = append(*inputSources, "receiver.http")
 		}
 
@@ -41,6 +41,15 @@ var collectorMinimalAllowlist = &metricAllowlistConfig{
 		"vector_buffer_events",
 		"vector_buffer_sent_events_total",
 		"vector_events_in_total",
+
+		// Metrics of the records dropped by throttle filters
+		"vector_events_discarded_total",
+
+		// Metrics of the requests rejected by the authentication of receivers
+		"logcollector_receiver_rejected_requests_count",
+
+		// Metrics defined by users with logToMetric filters
+		"logcollector_filter_.+",
 	},
 }
 
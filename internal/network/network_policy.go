@@ -18,7 +18,7 @@ import (
 // ReconcileClusterLogForwarderNetworkPolicy reconciles the NetworkPolicy for the clusterlogforwarder
 // It handles both AllowAllIngressEgress and RestrictIngressEgress rule sets, parsing ports from outputs and inputs when needed.
 func ReconcileClusterLogForwarderNetworkPolicy(k8Client client.Client, namespace, policyName, instanceName, component string, policyRuleSet obsv1.NetworkPolicyRuleSetType, outputs []obsv1.OutputSpec, inputs []obsv1.InputSpec, ownerRef metav1.OwnerReference, visitor func(o runtime.Object)) error {
-	egressPorts := DetermineEgressPortProtocols(outputs, policyRuleSet)
+	egressPorts := DetermineEgressPortProtocols(outputs, inputs, policyRuleSet)
 	ingressPorts := DetermineIngressPortProtocols(inputs, policyRuleSet)
 
 	desired := factory.NewNetworkPolicyWithProtocolPorts(namespace, policyName, instanceName, component, string(policyRuleSet), egressPorts, ingressPorts, visitor)
@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/factory"
 	"github.com/openshift/cluster-logging-operator/internal/utils"
@@ -15,15 +16,18 @@ import (
 
 var defaultHTTPSTCPPort = factory.PortProtocol{Port: constants.DefaultHTTPSPort, Protocol: corev1.ProtocolTCP}
 
-// DetermineEgressPortProtocols determines the egress ports needed based on outputs and policy rule set.
-// Returns collected ports from outputs + proxy configuration for RestrictIngressEgress.
-func DetermineEgressPortProtocols(outputs []obs.OutputSpec, policyRuleSet obs.NetworkPolicyRuleSetType) []factory.PortProtocol {
+// DetermineEgressPortProtocols determines the egress ports needed based on outputs, inputs and policy rule set.
+// Returns collected ports from outputs + kafka input brokers + proxy configuration for RestrictIngressEgress.
+func DetermineEgressPortProtocols(outputs []obs.OutputSpec, inputs []obs.InputSpec, policyRuleSet obs.NetworkPolicyRuleSetType) []factory.PortProtocol {
 	if policyRuleSet != obs.NetworkPolicyRuleSetTypeRestrictIngressEgress {
 		return nil
 	}
 
-	// Collect output ports and proxy ports
+	// Collect output ports, kafka broker ports and proxy ports
 	egressPortMap := GetOutputPortsWithProtocols(outputs)
+	for _, pp := range GetKafkaInputPortProtocols(inputs) {
+		egressPortMap[pp] = true
+	}
 	for pp := range GetProxyPorts() {
 		egressPortMap[pp] = true
 	}
@@ -37,12 +41,12 @@ func DetermineEgressPortProtocols(outputs []obs.OutputSpec, policyRuleSet obs.Ne
 }
 
 // DetermineIngressPortProtocols determines the ingress ports needed based on inputs and policy rule set.
-// Returns ports from receiver inputs for RestrictIngressEgress.
-func DetermineIngressPortProtocols(inputs []obs.InputSpec, policyRuleSet obs.NetworkPolicyRuleSetType) []int32 {
+// Returns ports with their protocols from receiver inputs for RestrictIngressEgress.
+func DetermineIngressPortProtocols(inputs []obs.InputSpec, policyRuleSet obs.NetworkPolicyRuleSetType) []factory.PortProtocol {
 	if policyRuleSet != obs.NetworkPolicyRuleSetTypeRestrictIngressEgress {
 		return nil
 	}
-	return GetInputPorts(inputs)
+	return GetInputPortProtocols(inputs)
 }
 
 // GetOutputPortsWithProtocols extracts all unique ports with their protocols from the given outputs based on their URL(s).
@@ -62,25 +66,70 @@ func GetOutputPortsWithProtocols(outputs []obs.OutputSpec) map[factory.PortProto
 	return portProtocolMap
 }
 
-// GetInputPorts extracts all unique ports from the given input receiver specs.
-// It returns the ports that input receivers are configured to listen on.
-func GetInputPorts(inputs []obs.InputSpec) []int32 {
-	portSet := sets.NewInt32()
+// GetKafkaInputPortProtocols extracts all unique ports with their protocols of the brokers kafka inputs consume from.
+func GetKafkaInputPortProtocols(inputs []obs.InputSpec) []factory.PortProtocol {
+	portProtocolSet := sets.New[factory.PortProtocol]()
+	for _, input := range inputs {
+		if input.Type == obs.InputTypeKafka && input.Kafka != nil {
+			for _, broker := range input.Kafka.Brokers {
+				if pp := parsePortProtocolFromURL(string(broker)); pp != nil {
+					portProtocolSet.Insert(*pp)
+				}
+			}
+		}
+	}
+	return portProtocolSet.UnsortedList()
+}
+
+// GetInputPortProtocols extracts all unique ports with their protocols from the given input receiver specs.
+// It returns the ports and protocols that input receivers are configured to listen on.
+func GetInputPortProtocols(inputs []obs.InputSpec) []factory.PortProtocol {
+	portProtocolSet := sets.New[factory.PortProtocol]()
 
 	for _, input := range inputs {
 		if input.Type == obs.InputTypeReceiver && input.Receiver != nil && input.Receiver.Port > 0 {
-			portSet.Insert(input.Receiver.Port)
+			portProtocolSet.Insert(ReceiverPortProtocols(input.Receiver)...)
 		}
 	}
 
-	return portSet.UnsortedList()
+	return portProtocolSet.UnsortedList()
+}
+
+// ReceiverPortProtocols returns the ports with their protocols a receiver listens on.
+// An OTLP receiver listening on both protocols additionally listens on its gRPC port
+func ReceiverPortProtocols(receiver *obs.ReceiverSpec) []factory.PortProtocol {
+	portProtocols := []factory.PortProtocol{}
+	for _, protocol := range ReceiverProtocols(receiver) {
+		portProtocols = append(portProtocols, factory.PortProtocol{Port: receiver.Port, Protocol: protocol})
+	}
+	if receiver.Type == obs.ReceiverTypeOTLP && internalobs.OTLPReceiverProtocol(receiver) == obs.OTLPReceiverProtocolBoth && receiver.OTLP.GRPCPort > 0 {
+		portProtocols = append(portProtocols, factory.PortProtocol{Port: receiver.OTLP.GRPCPort, Protocol: corev1.ProtocolTCP})
+	}
+	return portProtocols
+}
+
+// ReceiverProtocols returns the transport protocols a receiver listens on.
+// Syslog receivers listen on TCP, UDP or both depending upon their mode. All other receivers listen on TCP
+func ReceiverProtocols(receiver *obs.ReceiverSpec) []corev1.Protocol {
+	if receiver == nil || receiver.Type != obs.ReceiverTypeSyslog {
+		return []corev1.Protocol{corev1.ProtocolTCP}
+	}
+	switch internalobs.SyslogReceiverMode(receiver) {
+	case obs.SyslogReceiverModeUDP:
+		return []corev1.Protocol{corev1.ProtocolUDP}
+	case obs.SyslogReceiverModeBoth:
+		return []corev1.Protocol{corev1.ProtocolTCP, corev1.ProtocolUDP}
+	default:
+		return []corev1.Protocol{corev1.ProtocolTCP}
+	}
 }
 
 // getPortProtocolFromOutputURLs extracts all ports with protocols from an output spec's URL.
 // For most outputs, it returns a slice with a single port protocol.
-// For Kafka, it returns ports from all brokers or the URL if provided.
+// For Kafka, it returns ports from all brokers or the URL if provided, and from the OAuth token URL.
 // For HTTP, it returns ports from the URL and proxy URL if provided.
-// Returns port 443 for Google Cloud Logging and Azure Monitor as well as Cloudwatch and S3 if no URL is provided.
+// Returns port 443 for Google Cloud Logging and Azure Monitor as well as Cloudwatch, S3, Azure Blob and
+// Google Cloud Storage if no URL is provided.
 func getPortProtocolFromOutputURLs(output obs.OutputSpec) []factory.PortProtocol {
 	// Gather all URL strings from the output spec
 	var urlSlice []string
@@ -113,6 +162,10 @@ func getPortProtocolFromOutputURLs(output obs.OutputSpec) []factory.PortProtocol
 	case obs.OutputTypeKafka:
 		if output.Kafka != nil {
 			urlSlice = append(urlSlice, getKafkaAndBrokerURLs(*output.Kafka)...)
+			// librdkafka fetches the tokens of the OAUTHBEARER mechanism from the token endpoint
+			if auth := output.Kafka.Authentication; auth != nil && auth.SASL != nil && auth.SASL.OAuth != nil {
+				urlSlice = append(urlSlice, auth.SASL.OAuth.TokenURL)
+			}
 		}
 	case obs.OutputTypeCloudwatch:
 		if output.Cloudwatch == nil {
@@ -132,6 +185,24 @@ func getPortProtocolFromOutputURLs(output obs.OutputSpec) []factory.PortProtocol
 			return []factory.PortProtocol{defaultHTTPSTCPPort}
 		}
 		urlSlice = append(urlSlice, output.S3.URL)
+	case obs.OutputTypeAzureBlob:
+		if output.AzureBlob == nil {
+			return nil
+		}
+		// Azure Blob URL is optional; default to HTTPS port 443 when not specified
+		if output.AzureBlob.URL == "" {
+			return []factory.PortProtocol{defaultHTTPSTCPPort}
+		}
+		urlSlice = append(urlSlice, output.AzureBlob.URL)
+	case obs.OutputTypeGoogleCloudStorage:
+		if output.GoogleCloudStorage == nil {
+			return nil
+		}
+		// GCS URL is optional; default to HTTPS port 443 when not specified
+		if output.GoogleCloudStorage.URL == "" {
+			return []factory.PortProtocol{defaultHTTPSTCPPort}
+		}
+		urlSlice = append(urlSlice, output.GoogleCloudStorage.URL)
 	case obs.OutputTypeAzureLogsIngestion:
 		if output.AzureLogsIngestion != nil {
 			urlSlice = append(urlSlice, output.AzureLogsIngestion.URL)
@@ -277,6 +277,34 @@ var _ = Describe("Network Ports", func() {
 				"", constants.DefaultHTTPSPort),
 		)
 
+		DescribeTable("AzureBlob",
+			func(urlStr string, expectedPort int32) {
+				output := obs.OutputSpec{
+					Type:      obs.OutputTypeAzureBlob,
+					AzureBlob: &obs.AzureBlob{URL: urlStr},
+				}
+				Expect(getPortProtocolFromOutputURLs(output)).To(Equal(makeTCPPorts(expectedPort)))
+			},
+			Entry("should extract port from Azure Blob URL",
+				"http://azurite.example.com:10000", int32(10000)),
+			Entry("should use default HTTPS port when URL is not defined",
+				"", constants.DefaultHTTPSPort),
+		)
+
+		DescribeTable("GoogleCloudStorage",
+			func(urlStr string, expectedPort int32) {
+				output := obs.OutputSpec{
+					Type:               obs.OutputTypeGoogleCloudStorage,
+					GoogleCloudStorage: &obs.GoogleCloudStorage{URL: urlStr},
+				}
+				Expect(getPortProtocolFromOutputURLs(output)).To(Equal(makeTCPPorts(expectedPort)))
+			},
+			Entry("should extract port from Google Cloud Storage URL",
+				"https://storage-psc.example.com:8443", int32(8443)),
+			Entry("should use default HTTPS port when URL is not defined",
+				"", constants.DefaultHTTPSPort),
+		)
+
 		DescribeTable("Kafka",
 			func(urlStr string, brokers []obs.BrokerURL, expectedPorts []int32) {
 				output := obs.OutputSpec{
@@ -302,6 +330,21 @@ var _ = Describe("Network Ports", func() {
 			),
 		)
 
+		It("should extract the port of the OAuth token URL of Kafka", func() {
+			output := obs.OutputSpec{
+				Type: obs.OutputTypeKafka,
+				Kafka: &obs.Kafka{
+					URL: "tls://kafka.example.com:9093",
+					Authentication: &obs.KafkaAuthentication{
+						SASL: &obs.SASLAuthentication{
+							OAuth: &obs.SASLOAuthentication{TokenURL: "https://idp.example.com:8443/oauth2/token"},
+						},
+					},
+				},
+			}
+			Expect(getPortProtocolFromOutputURLs(output)).To(Equal(makeTCPPorts(9093, 8443)))
+		})
+
 		It("should return port 8080 for LokiStack", func() {
 			output := obs.OutputSpec{
 				Type: obs.OutputTypeLokiStack,
@@ -453,7 +496,31 @@ var _ = Describe("Network Ports", func() {
 		})
 	})
 
-	Describe("GetInputPorts", func() {
+	Describe("GetKafkaInputPortProtocols", func() {
+		It("should extract the unique ports of the brokers of kafka inputs", func() {
+			inputs := []obs.InputSpec{
+				{
+					Type: obs.InputTypeKafka,
+					Kafka: &obs.KafkaInput{
+						Brokers: []obs.BrokerURL{"tls://broker1:9093", "tls://broker2:9093", "tls://broker3:9094"},
+					},
+				},
+				{
+					Type:     obs.InputTypeReceiver,
+					Receiver: &obs.ReceiverSpec{Type: obs.ReceiverTypeHTTP, Port: 8080},
+				},
+			}
+			Expect(GetKafkaInputPortProtocols(inputs)).To(ConsistOf(
+				factory.PortProtocol{Port: 9093, Protocol: corev1.ProtocolTCP},
+				factory.PortProtocol{Port: 9094, Protocol: corev1.ProtocolTCP},
+			))
+		})
+	})
+
+	Describe("GetInputPortProtocols", func() {
+		tcpPort := func(port int32) factory.PortProtocol {
+			return factory.PortProtocol{Port: port, Protocol: corev1.ProtocolTCP}
+		}
 		Context("with input receiver specs", func() {
 			It("should extract ports from HTTP receivers", func() {
 				inputs := []obs.InputSpec{
@@ -465,8 +532,8 @@ var _ = Describe("Network Ports", func() {
 						},
 					},
 				}
-				ports := GetInputPorts(inputs)
-				Expect(ports).To(ConsistOf(int32(8080)))
+				ports := GetInputPortProtocols(inputs)
+				Expect(ports).To(ConsistOf(tcpPort(8080)))
 			})
 
 			It("should extract ports from syslog receivers", func() {
@@ -479,8 +546,8 @@ var _ = Describe("Network Ports", func() {
 						},
 					},
 				}
-				ports := GetInputPorts(inputs)
-				Expect(ports).To(ConsistOf(int32(5140)))
+				ports := GetInputPortProtocols(inputs)
+				Expect(ports).To(ConsistOf(tcpPort(5140)))
 			})
 
 			It("should extract unique ports from multiple receivers", func() {
@@ -507,8 +574,50 @@ var _ = Describe("Network Ports", func() {
 						},
 					},
 				}
-				ports := GetInputPorts(inputs)
-				Expect(ports).To(ConsistOf(int32(8080), int32(5140), int32(5000)))
+				ports := GetInputPortProtocols(inputs)
+				Expect(ports).To(ConsistOf(tcpPort(8080), tcpPort(5140), tcpPort(5000)))
+			})
+
+			It("should extract the protocols of syslog receivers from their mode", func() {
+				inputs := []obs.InputSpec{
+					{
+						Type: obs.InputTypeReceiver,
+						Receiver: &obs.ReceiverSpec{
+							Type:   obs.ReceiverTypeSyslog,
+							Port:   5140,
+							Syslog: &obs.SyslogReceiver{Mode: obs.SyslogReceiverModeUDP},
+						},
+					},
+					{
+						Type: obs.InputTypeReceiver,
+						Receiver: &obs.ReceiverSpec{
+							Type:   obs.ReceiverTypeSyslog,
+							Port:   5141,
+							Syslog: &obs.SyslogReceiver{Mode: obs.SyslogReceiverModeBoth},
+						},
+					},
+				}
+				ports := GetInputPortProtocols(inputs)
+				Expect(ports).To(ConsistOf(
+					factory.PortProtocol{Port: 5140, Protocol: corev1.ProtocolUDP},
+					tcpPort(5141),
+					factory.PortProtocol{Port: 5141, Protocol: corev1.ProtocolUDP},
+				))
+			})
+
+			It("should extract the HTTP and gRPC ports of OTLP receivers listening on both protocols", func() {
+				inputs := []obs.InputSpec{
+					{
+						Type: obs.InputTypeReceiver,
+						Receiver: &obs.ReceiverSpec{
+							Type: obs.ReceiverTypeOTLP,
+							Port: 4318,
+							OTLP: &obs.OTLPReceiver{Protocol: obs.OTLPReceiverProtocolBoth, GRPCPort: 4317},
+						},
+					},
+				}
+				ports := GetInputPortProtocols(inputs)
+				Expect(ports).To(ConsistOf(tcpPort(4318), tcpPort(4317)))
 			})
 
 			It("should ignore non-receiver input types", func() {
@@ -523,7 +632,7 @@ var _ = Describe("Network Ports", func() {
 						Type: obs.InputTypeAudit,
 					},
 				}
-				ports := GetInputPorts(inputs)
+				ports := GetInputPortProtocols(inputs)
 				Expect(ports).To(BeEmpty())
 			})
 
@@ -534,7 +643,7 @@ var _ = Describe("Network Ports", func() {
 						Receiver: nil,
 					},
 				}
-				ports := GetInputPorts(inputs)
+				ports := GetInputPortProtocols(inputs)
 				Expect(ports).To(BeEmpty())
 			})
 
@@ -548,12 +657,12 @@ var _ = Describe("Network Ports", func() {
 						},
 					},
 				}
-				ports := GetInputPorts(inputs)
+				ports := GetInputPortProtocols(inputs)
 				Expect(ports).To(BeEmpty())
 			})
 
 			It("should handle empty input list", func() {
-				ports := GetInputPorts([]obs.InputSpec{})
+				ports := GetInputPortProtocols([]obs.InputSpec{})
 				Expect(ports).To(BeEmpty())
 			})
 
@@ -580,8 +689,8 @@ var _ = Describe("Network Ports", func() {
 						},
 					},
 				}
-				ports := GetInputPorts(inputs)
-				Expect(ports).To(ConsistOf(int32(8080), int32(5140)))
+				ports := GetInputPortProtocols(inputs)
+				Expect(ports).To(ConsistOf(tcpPort(8080), tcpPort(5140)))
 			})
 		})
 	})
@@ -1,6 +1,9 @@
 package network
 
 import (
+	"fmt"
+	"strings"
+
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/factory"
@@ -47,26 +50,35 @@ func ReconcileService(k8sClient client.Client, namespace, name, instanceName, co
 	return reconcile.Service(k8sClient, desired)
 }
 
-func ReconcileInputService(k8sClient client.Client, namespace, name, instance, certSecretName string, port, targetPort int32, receiverType obs.ReceiverType, owner metav1.OwnerReference, visitors func(o runtime.Object)) error {
+// ReconcileInputService reconciles the service that exposes a receiver input on each of the ports and protocols it listens on
+func ReconcileInputService(k8sClient client.Client, namespace, name, instance, certSecretName string, receiver *obs.ReceiverSpec, owner metav1.OwnerReference, visitors func(o runtime.Object)) error {
+	portProtocols := ReceiverPortProtocols(receiver)
+	ports := []v1.ServicePort{}
+	for _, portProtocol := range portProtocols {
+		servicePort := v1.ServicePort{
+			Port: portProtocol.Port,
+			TargetPort: intstr.IntOrString{
+				Type:   intstr.Int,
+				IntVal: portProtocol.Port,
+			},
+			Protocol: portProtocol.Protocol,
+		}
+		// Ports must be named when a service exposes more than one
+		if len(portProtocols) > 1 {
+			servicePort.Name = fmt.Sprintf("%s-%d", strings.ToLower(string(portProtocol.Protocol)), portProtocol.Port)
+		}
+		ports = append(ports, servicePort)
+	}
 	desired := factory.NewService(
 		name,
 		namespace,
 		constants.CollectorName,
 		instance,
-		[]v1.ServicePort{
-			{
-				Port: port,
-				TargetPort: intstr.IntOrString{
-					Type:   intstr.Int,
-					IntVal: targetPort,
-				},
-				Protocol: v1.ProtocolTCP,
-			},
-		},
+		ports,
 		withServiceTypeLabel(constants.ServiceTypeInput),
 		visitors,
 	)
-	desired.Labels[constants.LabelLoggingInputServiceType] = string(receiverType)
+	desired.Labels[constants.LabelLoggingInputServiceType] = string(receiver.Type)
 	selectors := runtime.Selectors(instance, constants.CollectorName, desired.Labels[constants.LabelK8sName])
 	desired.Spec.Selector = selectors
 
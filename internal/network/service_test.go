@@ -6,6 +6,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/runtime"
 	corev1 "k8s.io/api/core/v1"
@@ -73,4 +74,37 @@ var _ = Describe("Reconcile Service", func() {
 			To(Equal(certSecret))
 	})
 
+	It("should expose a syslog receiver on TCP and UDP in both mode", func() {
+		receiver := &obs.ReceiverSpec{
+			Type:   obs.ReceiverTypeSyslog,
+			Port:   port,
+			Syslog: &obs.SyslogReceiver{Mode: obs.SyslogReceiverModeBoth},
+		}
+		Expect(ReconcileInputService(reqClient, constants.OpenshiftNS, serviceName, serviceName, certSecret, receiver, owner, commonLabels)).To(Succeed())
+
+		Expect(reqClient.Get(context.TODO(), serviceKey, serviceInstance)).Should(Succeed())
+		Expect(serviceInstance.Spec.Ports).To(HaveLen(2))
+		Expect(serviceInstance.Spec.Ports[0].Protocol).To(Equal(corev1.ProtocolTCP))
+		Expect(serviceInstance.Spec.Ports[0].Name).To(Equal("tcp-1337"))
+		Expect(serviceInstance.Spec.Ports[1].Protocol).To(Equal(corev1.ProtocolUDP))
+		Expect(serviceInstance.Spec.Ports[1].Name).To(Equal("udp-1337"))
+		Expect(serviceInstance.Spec.Ports[1].Port).To(Equal(port))
+	})
+
+	It("should expose an OTLP receiver on its HTTP and gRPC ports", func() {
+		receiver := &obs.ReceiverSpec{
+			Type: obs.ReceiverTypeOTLP,
+			Port: port,
+			OTLP: &obs.OTLPReceiver{Protocol: obs.OTLPReceiverProtocolBoth, GRPCPort: 4317},
+		}
+		Expect(ReconcileInputService(reqClient, constants.OpenshiftNS, serviceName, serviceName, certSecret, receiver, owner, commonLabels)).To(Succeed())
+
+		Expect(reqClient.Get(context.TODO(), serviceKey, serviceInstance)).Should(Succeed())
+		Expect(serviceInstance.Spec.Ports).To(HaveLen(2))
+		Expect(serviceInstance.Spec.Ports[0].Port).To(Equal(port))
+		Expect(serviceInstance.Spec.Ports[1].Port).To(Equal(int32(4317)))
+		Expect(serviceInstance.Spec.Ports[1].Protocol).To(Equal(corev1.ProtocolTCP))
+		Expect(serviceInstance.Labels[constants.LabelLoggingInputServiceType]).To(Equal(string(obs.ReceiverTypeOTLP)))
+	})
+
 })
@@ -100,3 +100,14 @@ func DeleteRole(k8sClient client.Client, namespace, name string) error {
 	}
 	return err
 }
+
+func DeleteRoleBinding(k8sClient client.Client, namespace, name string) error {
+	object := runtime.NewRoleBinding(namespace, name, rbacv1.RoleRef{})
+	log.V(3).Info("Deleting RoleBinding", "namespace", namespace, "name", name)
+	err := k8sClient.Delete(context.TODO(), object)
+	// Ignore NotFound errors - resource is already deleted
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
@@ -33,6 +33,7 @@ var COMPONENT_IMAGES = map[string]string{
 	constants.VectorName:                 constants.VectorImageEnvVar,
 	constants.VectorReceiverName:         constants.VectorReceiverImageEnvVar,
 	constants.LogfilesmetricexporterName: constants.LogfilesmetricImageEnvVar,
+	constants.EventsWatcherName:          constants.EventsWatcherImageEnvVar,
 }
 
 func AsOwner(o runtime.Object) metav1.OwnerReference {
@@ -1,13 +1,104 @@
 package filters
 
 import (
+	"encoding/csv"
+	"fmt"
+	"net"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
 	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/collector/vrl"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	"github.com/openshift/cluster-logging-operator/internal/validations/observability/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func Validate(context internalcontext.ForwarderContext) {
 	filterMap := internalobs.FilterMap(context.Forwarder.Spec)
 	for _, filter := range filterMap {
-		internalobs.SetCondition(&context.Forwarder.Status.FilterConditions, ValidateFilter(*filter))
+		condition := ValidateFilter(*filter)
+		if condition.Status == metav1.ConditionTrue {
+			if messages := validateReferences(*filter, context); len(messages) > 0 {
+				condition.Status = metav1.ConditionFalse
+				condition.Reason = obs.ReasonValidationFailure
+				condition.Message = strings.Join(messages, ",")
+			}
+		}
+		if condition.Status == metav1.ConditionTrue && filter.Type == obs.FilterTypeTransform {
+			validateCompiled(*filter, context, &condition)
+		}
+		internalobs.SetCondition(&context.Forwarder.Status.FilterConditions, condition)
+	}
+}
+
+// validateReferences checks the secrets and configmaps referenced by a filter exist and hold a valid CIDR table
+func validateReferences(spec obs.FilterSpec, context internalcontext.ForwarderContext) []string {
+	if spec.Type == obs.FilterTypeEnrichIP && spec.EnrichIPFilterSpec != nil {
+		table := spec.EnrichIPFilterSpec.CIDRTable
+		if messages := common.ValidateValueReference([]*obs.ValueReference{&table}, context.Secrets, context.ConfigMaps); len(messages) > 0 {
+			return messages
+		}
+		var content string
+		if table.SecretName != "" {
+			content = string(context.Secrets[table.SecretName].Data[table.Key])
+		} else {
+			content = context.ConfigMaps[table.ConfigMapName].Data[table.Key]
+		}
+		if err := validateCIDRTable(content); err != nil {
+			return []string{fmt.Sprintf("%s: cidrTable %v", spec.Name, err)}
+		}
+	}
+	return nil
+}
+
+// validateCompiled sets the condition of a transform filter from the result of compiling its program with the
+// collector. A program is not valid until the collector compiled it
+func validateCompiled(spec obs.FilterSpec, context internalcontext.ForwarderContext, condition *metav1.Condition) {
+	results, _ := utils.GetOption(context.AdditionalContext, vrl.ResultsOption, vrl.Results{})
+	result, found := results[spec.Name]
+	switch {
+	case !found || result.Pending:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = obs.ReasonValidationPending
+		condition.Message = fmt.Sprintf("%s: VRL program is being compiled by the collector", spec.Name)
+	case result.Error != "":
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = obs.ReasonValidationFailure
+		condition.Message = fmt.Sprintf("%s: VRL program is invalid: %s", spec.Name, result.Error)
+	}
+}
+
+// validateCIDRTable checks the table has a cidr and a label column and each row is a network address in the canonical
+// form the collector looks up addresses with
+func validateCIDRTable(content string) error {
+	records, err := csv.NewReader(strings.NewReader(content)).ReadAll()
+	if err != nil {
+		return fmt.Errorf("is not a valid CSV table: %v", err)
+	}
+	cidrColumn, labelColumn := -1, -1
+	if len(records) > 0 {
+		for i, name := range records[0] {
+			switch strings.TrimSpace(name) {
+			case "cidr":
+				cidrColumn = i
+			case "label":
+				labelColumn = i
+			}
+		}
+	}
+	if cidrColumn < 0 || labelColumn < 0 {
+		return fmt.Errorf("must have a header row with the `cidr` and `label` columns")
+	}
+	for _, record := range records[1:] {
+		_, network, err := net.ParseCIDR(record[cidrColumn])
+		if err != nil {
+			return fmt.Errorf("has an invalid CIDR %q", record[cidrColumn])
+		}
+		if network.String() != record[cidrColumn] {
+			return fmt.Errorf("CIDR %q must be written as %q", record[cidrColumn], network.String())
+		}
 	}
+	return nil
 }
@@ -3,11 +3,15 @@ package filters
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/redact"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/transform"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/utils/set"
 )
 
@@ -15,16 +19,48 @@ var (
 	// Matches dot delimited paths with alphanumeric & `_`. Any other characters added in a segment will require quotes.
 	// Matches `.kubernetes.namespace_name` & `kubernetes."test-label/with slashes"` & `."@timestamp"`
 	pathExpRegex = regexp.MustCompile(`^(\.[a-zA-Z0-9_]+|\."[^"]+")(\.[a-zA-Z0-9_]+|\."[^"]+")*$`)
+
+	// Matches valid metric and label names
+	metricNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+	// Matches static values and field paths encased in `{}` like `{.kubernetes.namespace_name}/{.kubernetes.labels."app"}`.
+	// Must be the same as the validation pattern of the throttle key in the API
+	throttleKeyRegex = regexp.MustCompile(`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+\})*)*$`)
+)
+
+const (
+	// maxDedupeCacheSize bounds the duplicates of a record counted by a dedupe filter within a window
+	maxDedupeCacheSize = 10000
+
+	maxDedupeWindowSeconds = 300
 )
 
 func ValidateFilter(spec obs.FilterSpec) (condition metav1.Condition) {
 
 	var results []string
 	switch spec.Type {
+	case obs.FilterTypeDedupe:
+		results = append(results, validateDedupeFilter(spec)...)
 	case obs.FilterTypeDrop:
 		results = append(results, validateDropFilter(spec)...)
+	case obs.FilterTypeEnrichIP:
+		results = append(results, validateEnrichIPFilter(spec)...)
+	case obs.FilterTypeKubernetesMetadata:
+		results = append(results, validateKubernetesMetadataFilter(spec)...)
+	case obs.FilterTypeLogToMetric:
+		results = append(results, validateLogToMetricFilter(spec)...)
+	case obs.FilterTypeParse:
+		results = append(results, validateParseFilter(spec)...)
 	case obs.FilterTypePrune:
 		results = append(results, validatePruneFilter(spec)...)
+	case obs.FilterTypeRedact:
+		results = append(results, validateRedactFilter(spec)...)
+	case obs.FilterTypeSample:
+		results = append(results, validateSampleFilter(spec)...)
+	case obs.FilterTypeThrottle:
+		results = append(results, validateThrottleFilter(spec)...)
+	case obs.FilterTypeTransform:
+		results = append(results, validateTransformFilter(spec)...)
 	}
 	condition = internalobs.NewConditionFromPrefix(obs.ConditionTypeValidFilterPrefix, spec.Name, true, obs.ReasonValidationSuccess, fmt.Sprintf("filter %q is valid", spec.Name))
 	if len(results) > 0 {
@@ -35,16 +71,40 @@ func ValidateFilter(spec obs.FilterSpec) (condition metav1.Condition) {
 	return condition
 }
 
+// validateDedupeFilter validates the identity fields, cache size and window of a dedupe filter
+func validateDedupeFilter(filterSpec obs.FilterSpec) (results []string) {
+	spec := filterSpec.DedupeFilterSpec
+	if spec == nil || len(spec.Fields) == 0 {
+		return append(results, fmt.Sprintf("%s dedupe filter must define at least one of `fields`", filterSpec.Name))
+	}
+	for _, field := range spec.Fields {
+		if err := validateFieldPath(field); err != "" {
+			results = append(results, fmt.Sprintf("%s: %s", filterSpec.Name, err))
+		}
+	}
+	if spec.CacheSize < 0 || spec.CacheSize > maxDedupeCacheSize {
+		results = append(results, fmt.Sprintf("%s: cacheSize must be between 1 and %d", filterSpec.Name, maxDedupeCacheSize))
+	}
+	if spec.WindowSeconds < 0 || spec.WindowSeconds > maxDedupeWindowSeconds {
+		results = append(results, fmt.Sprintf("%s: windowSeconds must be between 1 and %d", filterSpec.Name, maxDedupeWindowSeconds))
+	}
+	return results
+}
+
 // validateDropFilter validates each test and their associated conditions in a drop filter.
 // It sets the filter status for the specific drop test index to better diagnose problems
 func validateDropFilter(filterSpec obs.FilterSpec) (results []string) {
 	if len(filterSpec.DropTestsSpec) == 0 {
 		results = append(results, fmt.Sprintf("%q drop filter must have at least one test spec'd", filterSpec.Name))
 	}
+	return append(results, ValidateDropTests(filterSpec.Name, filterSpec.DropTestsSpec)...)
+}
 
+// ValidateDropTests validates the conditions of each test, prefixing the results with the given name
+func ValidateDropTests(name string, dropTests []obs.DropTest) (results []string) {
 	var err error
 	// Validate each test
-	for i, dropTest := range filterSpec.DropTestsSpec {
+	for i, dropTest := range dropTests {
 		testErrors := []string{}
 		// For each test, validate conditions
 		for _, testCondition := range dropTest.DropConditions {
@@ -69,12 +129,136 @@ func validateDropFilter(filterSpec obs.FilterSpec) (results []string) {
 			}
 		}
 		if len(testErrors) != 0 {
-			results = append(results, fmt.Sprintf("%s: test[%d] %v", filterSpec.Name, i, testErrors))
+			results = append(results, fmt.Sprintf("%s: test[%d] %v", name, i, testErrors))
+		}
+	}
+	return results
+}
+
+// validateEnrichIPFilter validates the fields, target and CIDR table reference of an enrichIP filter
+func validateEnrichIPFilter(filterSpec obs.FilterSpec) (results []string) {
+	spec := filterSpec.EnrichIPFilterSpec
+	if spec == nil || len(spec.Fields) == 0 {
+		return append(results, fmt.Sprintf("%s enrichIP filter must define at least one of `fields`", filterSpec.Name))
+	}
+	for _, field := range spec.Fields {
+		if err := validateFieldPath(field); err != "" {
+			results = append(results, fmt.Sprintf("%s: %s", filterSpec.Name, err))
+		}
+	}
+	if spec.Target != "" {
+		if err := validateFieldPath(spec.Target); err != "" {
+			results = append(results, fmt.Sprintf("%s: target %s", filterSpec.Name, err))
 		}
 	}
+	if spec.CIDRTable.Key == "" || (spec.CIDRTable.ConfigMapName == "") == (spec.CIDRTable.SecretName == "") {
+		results = append(results, fmt.Sprintf("%s: cidrTable must define a `key` and exactly one of `configMapName`, `secretName`", filterSpec.Name))
+	}
 	return results
 }
 
+// validateKubernetesMetadataFilter validates the annotation keys of a kubernetesMetadata filter
+func validateKubernetesMetadataFilter(filterSpec obs.FilterSpec) (results []string) {
+	spec := filterSpec.KubernetesMetadataFilterSpec
+	if spec == nil || (len(spec.Annotations) == 0 && !spec.IncludeOwner) {
+		return append(results, fmt.Sprintf("%s kubernetesMetadata filter must define at least one of `annotations`, `includeOwner`", filterSpec.Name))
+	}
+	for _, key := range spec.Annotations {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			results = append(results, fmt.Sprintf("%s: annotation %q must be a valid annotation key: %s", filterSpec.Name, key, strings.Join(errs, "; ")))
+		}
+	}
+	return results
+}
+
+// validateLogToMetricFilter validates the names, fields, tests and tags of each metric in a logToMetric filter
+func validateLogToMetricFilter(filterSpec obs.FilterSpec) (results []string) {
+	if filterSpec.LogToMetricFilterSpec == nil || len(filterSpec.LogToMetricFilterSpec.Metrics) == 0 {
+		return append(results, fmt.Sprintf("%s logToMetric filter must define at least one metric", filterSpec.Name))
+	}
+	names := set.New[string]()
+	for i, metric := range filterSpec.LogToMetricFilterSpec.Metrics {
+		errList := []string{}
+		if !metricNameRegex.MatchString(metric.Name) {
+			errList = append(errList, fmt.Sprintf("name %q must be a valid metric name", metric.Name))
+		} else if names.Has(metric.Name) {
+			errList = append(errList, fmt.Sprintf("name %q must be unique", metric.Name))
+		}
+		names.Insert(metric.Name)
+		if metric.Field == "" && metric.Type != obs.LogMetricTypeCounter {
+			errList = append(errList, fmt.Sprintf("field is required for %s metrics", metric.Type))
+		} else if metric.Field != "" {
+			if err := validateFieldPath(metric.Field); err != "" {
+				errList = append(errList, err)
+			}
+		}
+		for tag, fieldPath := range metric.Tags {
+			if !metricNameRegex.MatchString(tag) {
+				errList = append(errList, fmt.Sprintf("tag %q must be a valid label name", tag))
+			}
+			if err := validateFieldPath(fieldPath); err != "" {
+				errList = append(errList, err)
+			}
+		}
+		sort.Strings(errList)
+		errList = append(errList, ValidateDropTests("tests", metric.Tests)...)
+		if len(errList) != 0 {
+			results = append(results, fmt.Sprintf("%s: metrics[%d] %v", filterSpec.Name, i, errList))
+		}
+	}
+	return results
+}
+
+// validateParseFilter validates the field paths and format configuration of a parse filter
+func validateParseFilter(filterSpec obs.FilterSpec) (results []string) {
+	spec := filterSpec.ParseFilterSpec
+	if spec == nil {
+		return results
+	}
+	errList := []string{}
+	for _, fieldPath := range []obs.FieldPath{spec.Source, spec.Target} {
+		if fieldPath == "" {
+			continue
+		}
+		if err := validateFieldPath(fieldPath); err != "" {
+			errList = append(errList, err)
+		}
+	}
+	switch spec.Format {
+	case obs.ParseFormatCSV:
+		if spec.CSV == nil || len(spec.CSV.Headers) == 0 {
+			errList = append(errList, "csv format must define at least one header")
+		} else if len(spec.CSV.Delimiter) > 1 {
+			// The length of the API counts characters but the collector requires a single byte
+			errList = append(errList, "csv delimiter must be a single ASCII character")
+		}
+	case obs.ParseFormatRegex:
+		if spec.Regex == nil || spec.Regex.Pattern == "" {
+			errList = append(errList, "regex format must define a pattern")
+		} else if strings.ContainsAny(spec.Regex.Pattern, "'\n\r") {
+			errList = append(errList, "regex pattern must not contain single quotes, newlines, or carriage returns")
+		} else if re, err := regexp.Compile(spec.Regex.Pattern); err != nil {
+			errList = append(errList, "regex pattern must be a valid regular expression")
+		} else if !hasNamedGroup(re) {
+			errList = append(errList, "regex pattern must define at least one named capture group")
+		}
+	}
+	if len(errList) != 0 {
+		results = append(results, fmt.Sprintf("%s: %v", filterSpec.Name, errList))
+	}
+	return results
+}
+
+// hasNamedGroup returns true if the regular expression defines a named capture group
+func hasNamedGroup(re *regexp.Regexp) bool {
+	for _, name := range re.SubexpNames() {
+		if name != "" {
+			return true
+		}
+	}
+	return false
+}
+
 func validatePruneFilter(filterSpec obs.FilterSpec) (results []string) {
 	if filterSpec.PruneFilterSpec == nil {
 		results = append(results, fmt.Sprintf("%s prune filter must have one or both of `in`, `notIn`", filterSpec.Name))
@@ -112,6 +296,75 @@ func validatePruneFilter(filterSpec obs.FilterSpec) (results []string) {
 	return results
 }
 
+// validateRedactFilter validates the field paths and regular expressions of a redact filter
+func validateRedactFilter(filterSpec obs.FilterSpec) (results []string) {
+	if filterSpec.RedactFilterSpec == nil {
+		return append(results, fmt.Sprintf("%s redact filter must define `fields` and one or both of `patterns`, `regexes`", filterSpec.Name))
+	}
+	spec := filterSpec.RedactFilterSpec
+	errList := []string{}
+	if len(spec.Fields) == 0 {
+		errList = append(errList, "at least one field must be defined")
+	}
+	for _, fieldPath := range spec.Fields {
+		if err := validateFieldPath(fieldPath); err != "" {
+			errList = append(errList, err)
+		}
+	}
+	if len(spec.Patterns) == 0 && len(spec.Regexes) == 0 {
+		errList = append(errList, "at least one of patterns or regexes must be defined")
+	}
+	for i, r := range spec.Regexes {
+		if err := redact.ValidateRegex(r); err != nil {
+			errList = append(errList, fmt.Sprintf("regexes[%d]: %v", i, err))
+		}
+	}
+	if len(errList) != 0 {
+		results = append(results, fmt.Sprintf("%s: %v", filterSpec.Name, errList))
+	}
+	return results
+}
+
+// validateSampleFilter validates the rate, key field and exclusions of a sample filter
+func validateSampleFilter(filterSpec obs.FilterSpec) (results []string) {
+	spec := filterSpec.SampleFilterSpec
+	if spec == nil || (spec.Rate == nil) == (spec.Percent == nil) {
+		return append(results, fmt.Sprintf("%s sample filter must define exactly one of `rate`, `percent`", filterSpec.Name))
+	}
+	if spec.KeyField != "" {
+		if err := validateFieldPath(spec.KeyField); err != "" {
+			results = append(results, fmt.Sprintf("%s: %s", filterSpec.Name, err))
+		}
+	}
+	return append(results, ValidateDropTests(filterSpec.Name+" exclude", spec.Exclude)...)
+}
+
+// validateThrottleFilter validates the key and threshold of a throttle filter
+func validateThrottleFilter(filterSpec obs.FilterSpec) (results []string) {
+	spec := filterSpec.ThrottleFilterSpec
+	if spec == nil {
+		return append(results, fmt.Sprintf("%s throttle filter must define `key` and `threshold`", filterSpec.Name))
+	}
+	if spec.Key == "" || !throttleKeyRegex.MatchString(spec.Key) {
+		results = append(results, fmt.Sprintf("%s: key %q must be a combination of static values and field paths encased in `{}`", filterSpec.Name, spec.Key))
+	}
+	if spec.Threshold < 1 {
+		results = append(results, fmt.Sprintf("%s: threshold must be greater than zero", filterSpec.Name))
+	}
+	return results
+}
+
+// validateTransformFilter verifies the VRL program of a transform filter passes the syntax check
+func validateTransformFilter(filterSpec obs.FilterSpec) (results []string) {
+	if filterSpec.TransformFilterSpec == nil || strings.TrimSpace(filterSpec.TransformFilterSpec.Source) == "" {
+		return append(results, fmt.Sprintf("%s transform filter must define a VRL `source`", filterSpec.Name))
+	}
+	if err := transform.CheckSyntax(filterSpec.TransformFilterSpec.Source); err != nil {
+		results = append(results, fmt.Sprintf("%s: VRL program is invalid: %v", filterSpec.Name, err))
+	}
+	return results
+}
+
 // validateFieldPath validates a field path for correctness
 func validateFieldPath(fieldPath obs.FieldPath) string {
 	path := string(fieldPath)
@@ -4,16 +4,126 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
 	. "github.com/openshift/cluster-logging-operator/test/matchers"
 )
 
 var _ = Describe("[internal][validations][observability][filters]", func() {
 	const (
+		myDedupe           = "dedupeFilter"
 		myDrop             = "dropFilter"
+		myEnrichIP         = "enrichIPFilter"
 		myPrune            = "pruneFilter"
+		myKubernetesMeta   = "kubernetesMetadataFilter"
+		myLogToMetric      = "logToMetricFilter"
+		myParse            = "parseFilter"
+		myRedact           = "redactFilter"
+		mySample           = "sampleFilter"
+		myThrottle         = "throttleFilter"
+		myTransform        = "transformFilter"
 		expConditionTypeRE = obs.ConditionTypeValidFilterPrefix + "-.*"
 	)
 
+	Context("#validateDedupeFilter", func() {
+		DescribeTable("dedupe filter spec", func(dedupeSpec *obs.DedupeFilterSpec, valid bool, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:             myDedupe,
+				Type:             obs.FilterTypeDedupe,
+				DedupeFilterSpec: dedupeSpec,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, valid, "", errMsg))
+		},
+			Entry("should pass with fields, cache size and window",
+				&obs.DedupeFilterSpec{
+					Fields:        []obs.FieldPath{".kubernetes.namespace_name", `.kubernetes.labels."app.kubernetes.io/name"`, ".message"},
+					CacheSize:     5000,
+					WindowSeconds: 60,
+				}, true, "is valid"),
+			Entry("should pass with the default cache size and window",
+				&obs.DedupeFilterSpec{
+					Fields: []obs.FieldPath{".message"},
+				}, true, "is valid"),
+			Entry("should fail without a dedupe spec", nil, false, "dedupe filter must define at least one of `fields`"),
+			Entry("should fail with an invalid field path",
+				&obs.DedupeFilterSpec{
+					Fields: []obs.FieldPath{"message"},
+				}, false, `"message" must start with a '.'`),
+			Entry("should fail with a cache size exceeding the maximum",
+				&obs.DedupeFilterSpec{
+					Fields:    []obs.FieldPath{".message"},
+					CacheSize: 10001,
+				}, false, "cacheSize must be between 1 and 10000"),
+			Entry("should fail with a window exceeding the maximum",
+				&obs.DedupeFilterSpec{
+					Fields:        []obs.FieldPath{".message"},
+					WindowSeconds: 301,
+				}, false, "windowSeconds must be between 1 and 300"),
+		)
+	})
+
+	Context("#validateEnrichIPFilter", func() {
+		DescribeTable("enrichIP filter spec", func(enrichIPSpec *obs.EnrichIPFilterSpec, valid bool, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:               myEnrichIP,
+				Type:               obs.FilterTypeEnrichIP,
+				EnrichIPFilterSpec: enrichIPSpec,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, valid, "", errMsg))
+		},
+			Entry("should pass with fields, target and a configmap table",
+				&obs.EnrichIPFilterSpec{
+					Fields:    []obs.FieldPath{".sourceIPs", `.structured."src-ip"`},
+					Target:    ".zone",
+					CIDRTable: obs.ValueReference{Key: "zones.csv", ConfigMapName: "zones"},
+				}, true, "is valid"),
+			Entry("should pass with a secret table",
+				&obs.EnrichIPFilterSpec{
+					Fields:    []obs.FieldPath{".sourceIPs"},
+					CIDRTable: obs.ValueReference{Key: "zones.csv", SecretName: "zones"},
+				}, true, "is valid"),
+			Entry("should fail without an enrichIP spec", nil, false, "enrichIP filter must define at least one of `fields`"),
+			Entry("should fail with an invalid field path",
+				&obs.EnrichIPFilterSpec{
+					Fields:    []obs.FieldPath{"sourceIPs"},
+					CIDRTable: obs.ValueReference{Key: "zones.csv", ConfigMapName: "zones"},
+				}, false, `"sourceIPs" must start with a '.'`),
+			Entry("should fail with an invalid target",
+				&obs.EnrichIPFilterSpec{
+					Fields:    []obs.FieldPath{".sourceIPs"},
+					Target:    ".zone-name",
+					CIDRTable: obs.ValueReference{Key: "zones.csv", ConfigMapName: "zones"},
+				}, false, `target ".zone-name" must be a valid dot delimited path`),
+			Entry("should fail with a table without a configmap or secret",
+				&obs.EnrichIPFilterSpec{
+					Fields:    []obs.FieldPath{".sourceIPs"},
+					CIDRTable: obs.ValueReference{Key: "zones.csv"},
+				}, false, "cidrTable must define a `key` and exactly one of `configMapName`, `secretName`"),
+		)
+	})
+
+	Context("#validateKubernetesMetadataFilter", func() {
+		DescribeTable("kubernetesMetadata filter spec", func(kubernetesMetadataSpec *obs.KubernetesMetadataFilterSpec, valid bool, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:                         myKubernetesMeta,
+				Type:                         obs.FilterTypeKubernetesMetadata,
+				KubernetesMetadataFilterSpec: kubernetesMetadataSpec,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, valid, "", errMsg))
+		},
+			Entry("should pass with annotations and owner",
+				&obs.KubernetesMetadataFilterSpec{
+					Annotations:  []string{"openshift.io/scc", "team"},
+					IncludeOwner: true,
+				}, true, "is valid"),
+			Entry("should fail without a kubernetesMetadata spec", nil, false, "must define at least one of `annotations`, `includeOwner`"),
+			Entry("should fail without annotations or owner", &obs.KubernetesMetadataFilterSpec{}, false, "must define at least one of `annotations`, `includeOwner`"),
+			Entry("should fail with an invalid annotation key",
+				&obs.KubernetesMetadataFilterSpec{
+					Annotations: []string{"openshift.io/scc/extra"},
+				}, false, `annotation "openshift.io/scc/extra" must be a valid annotation key`),
+		)
+	})
+
 	Context("#validateDropFilters", func() {
 		DescribeTable("invalid fields and matches/notMatches", func(dropTests []obs.DropTest, errMsg string) {
 			spec := obs.FilterSpec{
@@ -385,4 +495,260 @@ var _ = Describe("[internal][validations][observability][filters]", func() {
 		})
 
 	})
+
+	Context("#validateLogToMetricFilter", func() {
+		DescribeTable("logToMetric filter spec", func(metrics []obs.LogMetric, valid bool, errMsg string) {
+			spec := obs.FilterSpec{
+				Name: myLogToMetric,
+				Type: obs.FilterTypeLogToMetric,
+				LogToMetricFilterSpec: &obs.LogToMetricFilterSpec{
+					Metrics: metrics,
+				},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, valid, "", errMsg))
+		},
+			Entry("should pass with a counter and a histogram",
+				[]obs.LogMetric{
+					{
+						Name:  "errors_total",
+						Type:  obs.LogMetricTypeCounter,
+						Tests: []obs.DropTest{{DropConditions: []obs.DropCondition{{Field: ".level", Matches: "error"}}}},
+						Tags:  map[string]obs.FieldPath{"namespace": ".kubernetes.namespace_name"},
+					},
+					{Name: "duration_ms", Type: obs.LogMetricTypeHistogram, Field: ".duration_ms"},
+				}, true, "is valid"),
+			Entry("should fail without metrics", nil, false, "must define at least one metric"),
+			Entry("should fail with an invalid metric name",
+				[]obs.LogMetric{{Name: "errors-total", Type: obs.LogMetricTypeCounter}},
+				false, `metrics\[0\].+must be a valid metric name`),
+			Entry("should fail with duplicate metric names",
+				[]obs.LogMetric{
+					{Name: "errors_total", Type: obs.LogMetricTypeCounter},
+					{Name: "errors_total", Type: obs.LogMetricTypeCounter},
+				}, false, `metrics\[1\].+must be unique`),
+			Entry("should fail with a gauge without a field",
+				[]obs.LogMetric{{Name: "size", Type: obs.LogMetricTypeGauge}},
+				false, "field is required for gauge metrics"),
+			Entry("should fail with an invalid tag",
+				[]obs.LogMetric{{
+					Name: "errors_total",
+					Type: obs.LogMetricTypeCounter,
+					Tags: map[string]obs.FieldPath{"name-space": "kubernetes.namespace_name"},
+				}}, false, `must start with a '.'.+tag "name-space" must be a valid label name`),
+			Entry("should fail with an invalid test",
+				[]obs.LogMetric{{
+					Name:  "errors_total",
+					Type:  obs.LogMetricTypeCounter,
+					Tests: []obs.DropTest{{DropConditions: []obs.DropCondition{{Field: ".level", Matches: "(error"}}}},
+				}}, false, `tests: test\[0\].+must be a valid regular expression`),
+		)
+	})
+
+	Context("#validateParseFilter", func() {
+		DescribeTable("parse filter spec", func(parseSpec *obs.ParseFilterSpec, valid bool, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:            myParse,
+				Type:            obs.FilterTypeParse,
+				ParseFilterSpec: parseSpec,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, valid, "", errMsg))
+		},
+			Entry("should pass without a spec", nil, true, "is valid"),
+			Entry("should pass with a csv format",
+				&obs.ParseFilterSpec{
+					Format: obs.ParseFormatCSV,
+					Source: ".message",
+					Target: ".structured",
+					CSV:    &obs.ParseCSVSpec{Headers: []string{"level", "msg"}},
+				}, true, "is valid"),
+			Entry("should pass with a regex with named groups",
+				&obs.ParseFilterSpec{
+					Format: obs.ParseFormatRegex,
+					Regex:  &obs.ParseRegexSpec{Pattern: `^(?P<level>\w+) (?P<msg>.*)$`},
+				}, true, "is valid"),
+			Entry("should fail with an invalid target",
+				&obs.ParseFilterSpec{Target: "structured"}, false, "must start with a '.'"),
+			Entry("should pass with a single byte csv delimiter",
+				&obs.ParseFilterSpec{
+					Format: obs.ParseFormatCSV,
+					CSV:    &obs.ParseCSVSpec{Headers: []string{"level", "msg"}, Delimiter: ";"},
+				}, true, "is valid"),
+			Entry("should fail with a multibyte csv delimiter",
+				&obs.ParseFilterSpec{
+					Format: obs.ParseFormatCSV,
+					CSV:    &obs.ParseCSVSpec{Headers: []string{"level", "msg"}, Delimiter: "§"},
+				}, false, "csv delimiter must be a single ASCII character"),
+			Entry("should fail with a csv format without headers",
+				&obs.ParseFilterSpec{Format: obs.ParseFormatCSV}, false, "csv format must define at least one header"),
+			Entry("should fail with an invalid regex",
+				&obs.ParseFilterSpec{
+					Format: obs.ParseFormatRegex,
+					Regex:  &obs.ParseRegexSpec{Pattern: `^(?P<level>\w+`},
+				}, false, "must be a valid regular expression"),
+			Entry("should fail with a regex without named groups",
+				&obs.ParseFilterSpec{
+					Format: obs.ParseFormatRegex,
+					Regex:  &obs.ParseRegexSpec{Pattern: `^(\w+) (.*)$`},
+				}, false, "must define at least one named capture group"),
+		)
+	})
+
+	Context("#validateRedactFilter", func() {
+		DescribeTable("redact filter spec", func(redactSpec *obs.RedactFilterSpec, valid bool, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:             myRedact,
+				Type:             obs.FilterTypeRedact,
+				RedactFilterSpec: redactSpec,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, valid, "", errMsg))
+		},
+			Entry("should pass with valid fields, patterns and regexes",
+				&obs.RedactFilterSpec{
+					Fields:   []obs.FieldPath{".message", `.kubernetes.labels."foo-bar/baz"`},
+					Patterns: []obs.RedactPatternType{obs.RedactPatternCreditCard},
+					Regexes:  []string{`password=\S+`},
+				}, true, "is valid"),
+			Entry("should fail without a redact spec", nil, false, "redact filter must define `fields`"),
+			Entry("should fail with an invalid field path",
+				&obs.RedactFilterSpec{
+					Fields:   []obs.FieldPath{"message"},
+					Patterns: []obs.RedactPatternType{obs.RedactPatternEmail},
+				}, false, "must start with a '.'"),
+			Entry("should fail without patterns or regexes",
+				&obs.RedactFilterSpec{
+					Fields: []obs.FieldPath{".message"},
+				}, false, "at least one of patterns or regexes must be defined"),
+			Entry("should fail with a regex that does not compile",
+				&obs.RedactFilterSpec{
+					Fields:  []obs.FieldPath{".message"},
+					Regexes: []string{`(foo`},
+				}, false, `regexes\[0\]: invalid regular expression`),
+			Entry("should fail with a regex the collector does not support",
+				&obs.RedactFilterSpec{
+					Fields:  []obs.FieldPath{".message"},
+					Regexes: []string{`\Qa.b\E`},
+				}, false, `regexes\[0\]: invalid regular expression`),
+		)
+	})
+
+	Context("#validateSampleFilter", func() {
+		DescribeTable("sample filter spec", func(sampleSpec *obs.SampleFilterSpec, valid bool, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:             mySample,
+				Type:             obs.FilterTypeSample,
+				SampleFilterSpec: sampleSpec,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, valid, "", errMsg))
+		},
+			Entry("should pass with a rate, key field and exclusions",
+				&obs.SampleFilterSpec{
+					Rate:     utils.GetPtr[int64](10),
+					KeyField: ".kubernetes.pod_name",
+					Exclude: []obs.DropTest{
+						{DropConditions: []obs.DropCondition{{Field: ".level", Matches: "error"}}},
+					},
+				}, true, "is valid"),
+			Entry("should fail without a sample spec", nil, false, "must define exactly one of `rate`, `percent`"),
+			Entry("should fail with both rate and percent",
+				&obs.SampleFilterSpec{
+					Rate:    utils.GetPtr[int64](10),
+					Percent: utils.GetPtr[int32](10),
+				}, false, "must define exactly one of `rate`, `percent`"),
+			Entry("should fail with an invalid key field",
+				&obs.SampleFilterSpec{
+					Percent:  utils.GetPtr[int32](10),
+					KeyField: "kubernetes.pod_name",
+				}, false, "must start with a '.'"),
+			Entry("should fail with an invalid exclusion",
+				&obs.SampleFilterSpec{
+					Percent: utils.GetPtr[int32](10),
+					Exclude: []obs.DropTest{
+						{DropConditions: []obs.DropCondition{{Field: ".level", Matches: "(error"}}},
+					},
+				}, false, `sampleFilter exclude: test\[0\].+must be a valid regular expression`),
+		)
+	})
+
+	Context("#validateThrottleFilter", func() {
+		DescribeTable("throttle filter spec", func(throttleSpec *obs.ThrottleFilterSpec, valid bool, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:               myThrottle,
+				Type:               obs.FilterTypeThrottle,
+				ThrottleFilterSpec: throttleSpec,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, valid, "", errMsg))
+		},
+			Entry("should pass with a templated key",
+				&obs.ThrottleFilterSpec{
+					Key:       `ns-{.kubernetes.namespace_name}/{.kubernetes.labels."app.kubernetes.io/name"}`,
+					Threshold: 100,
+				}, true, "is valid"),
+			Entry("should pass with a static key",
+				&obs.ThrottleFilterSpec{
+					Key:       "all-logs",
+					Threshold: 100,
+				}, true, "is valid"),
+			Entry("should pass with a key of adjacent field paths",
+				&obs.ThrottleFilterSpec{
+					Key:       `{.kubernetes.namespace_name}{.kubernetes.pod_name}`,
+					Threshold: 100,
+				}, true, "is valid"),
+			Entry("should fail without a throttle spec", nil, false, "throttle filter must define `key` and `threshold`"),
+			Entry("should fail with empty curly brackets",
+				&obs.ThrottleFilterSpec{
+					Key:       "ns-{}",
+					Threshold: 100,
+				}, false, "must be a combination of static values and field paths"),
+			Entry("should fail with unterminated curly brackets",
+				&obs.ThrottleFilterSpec{
+					Key:       "{.kubernetes.namespace_name",
+					Threshold: 100,
+				}, false, "must be a combination of static values and field paths"),
+			Entry("should fail with an empty key",
+				&obs.ThrottleFilterSpec{
+					Threshold: 100,
+				}, false, "must be a combination of static values and field paths"),
+			Entry("should fail with a key that is not a field path",
+				&obs.ThrottleFilterSpec{
+					Key:       "{kubernetes.namespace_name}",
+					Threshold: 100,
+				}, false, "must be a combination of static values and field paths"),
+			Entry("should fail with a threshold less than one",
+				&obs.ThrottleFilterSpec{
+					Key: "{.kubernetes.namespace_name}",
+				}, false, "threshold must be greater than zero"),
+		)
+	})
+
+	Context("#validateTransformFilter", func() {
+		It("should pass validation for a program that compiles", func() {
+			spec := obs.FilterSpec{
+				Name: myTransform,
+				Type: obs.FilterTypeTransform,
+				TransformFilterSpec: &obs.TransformFilterSpec{
+					Source: `.app = "foo"`,
+				},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, "is valid"))
+		})
+
+		It("should fail validation if transform filter spec'd without transformFilterSpec", func() {
+			spec := obs.FilterSpec{
+				Name: myTransform,
+				Type: obs.FilterTypeTransform,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "transform filter must define a VRL `source`"))
+		})
+
+		It("should fail validation if the program has a syntax error", func() {
+			spec := obs.FilterSpec{
+				Name: myTransform,
+				Type: obs.FilterTypeTransform,
+				TransformFilterSpec: &obs.TransformFilterSpec{
+					Source: `.app = "foo`,
+				},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "VRL program is invalid: syntax error at line 1, column 8: unterminated string literal"))
+		})
+	})
 })
@@ -0,0 +1,116 @@
+package filters
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	"github.com/openshift/cluster-logging-operator/internal/collector/vrl"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("#Validate", func() {
+	var (
+		context internalcontext.ForwarderContext
+	)
+
+	BeforeEach(func() {
+		context = internalcontext.ForwarderContext{
+			Forwarder: &obs.ClusterLogForwarder{
+				Spec: obs.ClusterLogForwarderSpec{
+					Filters: []obs.FilterSpec{
+						{
+							Name: "my-zones",
+							Type: obs.FilterTypeEnrichIP,
+							EnrichIPFilterSpec: &obs.EnrichIPFilterSpec{
+								Fields:    []obs.FieldPath{".sourceIPs"},
+								CIDRTable: obs.ValueReference{Key: "zones.csv", ConfigMapName: "zones"},
+							},
+						},
+					},
+				},
+			},
+			ConfigMaps: map[string]*corev1.ConfigMap{
+				"zones": {
+					ObjectMeta: metav1.ObjectMeta{Name: "zones"},
+					Data: map[string]string{
+						"zones.csv": "cidr,label\n10.0.0.0/8,internal",
+					},
+				},
+			},
+		}
+	})
+
+	It("should pass when the CIDR table of an enrichIP filter exists", func() {
+		Validate(context)
+		Expect(context.Forwarder.Status.FilterConditions).To(HaveCondition(obs.ConditionTypeValidFilterPrefix+"-my-zones", true, obs.ReasonValidationSuccess, "is valid"))
+	})
+
+	It("should fail when the configmap of the CIDR table of an enrichIP filter does not exist", func() {
+		context.ConfigMaps = map[string]*corev1.ConfigMap{}
+		Validate(context)
+		Expect(context.Forwarder.Status.FilterConditions).To(HaveCondition(obs.ConditionTypeValidFilterPrefix+"-my-zones", false, obs.ReasonValidationFailure, `configmap\[zones\] not found`))
+	})
+
+	It("should fail when the key of the CIDR table of an enrichIP filter does not exist", func() {
+		context.Forwarder.Spec.Filters[0].EnrichIPFilterSpec.CIDRTable.Key = "other.csv"
+		Validate(context)
+		Expect(context.Forwarder.Status.FilterConditions).To(HaveCondition(obs.ConditionTypeValidFilterPrefix+"-my-zones", false, obs.ReasonValidationFailure, `configmap\[zones.other.csv\] not found`))
+	})
+
+	DescribeTable("should fail when the CIDR table of an enrichIP filter is invalid", func(table, message string) {
+		context.ConfigMaps["zones"].Data["zones.csv"] = table
+		Validate(context)
+		Expect(context.Forwarder.Status.FilterConditions).To(HaveCondition(obs.ConditionTypeValidFilterPrefix+"-my-zones", false, obs.ReasonValidationFailure, message))
+	},
+		Entry("without a header row", "10.0.0.0/8,internal", "must have a header row with the `cidr` and `label` columns"),
+		Entry("with an invalid CIDR", "cidr,label\n10.0.0.0,internal", `has an invalid CIDR "10.0.0.0"`),
+		Entry("with a host address", "cidr,label\n10.0.0.1/8,internal", `CIDR "10.0.0.1/8" must be written as "10.0.0.0/8"`),
+		Entry("with a non canonical IPv6 network", "cidr,label\nFD00:0::/8,internal", `CIDR "FD00:0::/8" must be written as "fd00::/8"`),
+	)
+
+	It("should pass with IPv4 and IPv6 networks in any column order", func() {
+		context.ConfigMaps["zones"].Data["zones.csv"] = "label,cidr\ninternal,10.0.0.0/8\nlocal,fd00::/8"
+		Validate(context)
+		Expect(context.Forwarder.Status.FilterConditions).To(HaveCondition(obs.ConditionTypeValidFilterPrefix+"-my-zones", true, obs.ReasonValidationSuccess, "is valid"))
+	})
+
+	Context("for a transform filter", func() {
+		BeforeEach(func() {
+			context.Forwarder.Spec.Filters = []obs.FilterSpec{
+				{
+					Name:                "my-transform",
+					Type:                obs.FilterTypeTransform,
+					TransformFilterSpec: &obs.TransformFilterSpec{Source: `.foo = "bar"`},
+				},
+			}
+			context.AdditionalContext = utils.Options{}
+		})
+
+		It("should pass when the collector compiled the program", func() {
+			context.AdditionalContext[vrl.ResultsOption] = vrl.Results{"my-transform": {}}
+			Validate(context)
+			Expect(context.Forwarder.Status.FilterConditions).To(HaveCondition(obs.ConditionTypeValidFilterPrefix+"-my-transform", true, obs.ReasonValidationSuccess, "is valid"))
+		})
+
+		It("should fail with the compiler output when the collector rejected the program", func() {
+			context.AdditionalContext[vrl.ResultsOption] = vrl.Results{"my-transform": {Error: "error[E105]: call to undefined function"}}
+			Validate(context)
+			Expect(context.Forwarder.Status.FilterConditions).To(HaveCondition(obs.ConditionTypeValidFilterPrefix+"-my-transform", false, obs.ReasonValidationFailure, `VRL program is invalid: error\[E105\]: call to undefined function`))
+		})
+
+		It("should not pass while the collector compiles the program", func() {
+			context.AdditionalContext[vrl.ResultsOption] = vrl.Results{"my-transform": {Pending: true}}
+			Validate(context)
+			Expect(context.Forwarder.Status.FilterConditions).To(HaveCondition(obs.ConditionTypeValidFilterPrefix+"-my-transform", false, obs.ReasonValidationPending, "VRL program is being compiled by the collector"))
+		})
+
+		It("should not pass when the program was never compiled", func() {
+			Validate(context)
+			Expect(context.Forwarder.Status.FilterConditions).To(HaveCondition(obs.ConditionTypeValidFilterPrefix+"-my-transform", false, obs.ReasonValidationPending, "VRL program is being compiled by the collector"))
+		})
+	})
+})
@@ -0,0 +1,52 @@
+package inputs
+
+import (
+	"fmt"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// ValidateEvents validates events input specs. Events are collected by a single collector replica which is
+// deployed as a deployment and can not be combined with inputs collecting logs from the nodes
+func ValidateEvents(spec obs.InputSpec, forwarder obs.ClusterLogForwarderSpec) []metav1.Condition {
+	if spec.Type != obs.InputTypeEvents {
+		return nil
+	}
+	if spec.Events == nil {
+		return []metav1.Condition{
+			internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonMissingSpec, fmt.Sprintf("%s has nil events spec", spec.Name)),
+		}
+	}
+	if nodeInputs := nodeInputNames(forwarder); len(nodeInputs) > 0 {
+		return []metav1.Condition{
+			internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure,
+				fmt.Sprintf("%s can not be combined with inputs collecting logs from the nodes: %v", spec.Name, nodeInputs)),
+		}
+	}
+	return []metav1.Condition{
+		internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, true, obs.ReasonValidationSuccess, fmt.Sprintf("input %q is valid", spec.Name)),
+	}
+}
+
+// nodeInputNames returns the names of the inputs of the forwarder, including the reserved inputs referenced by
+// pipelines, which require a collector on every node
+func nodeInputNames(forwarder obs.ClusterLogForwarderSpec) []string {
+	names := sets.NewString()
+	for _, i := range forwarder.Inputs {
+		switch i.Type {
+		case obs.InputTypeApplication, obs.InputTypeInfrastructure, obs.InputTypeAudit, obs.InputTypeHostFile:
+			names.Insert(i.Name)
+		}
+	}
+	for _, p := range forwarder.Pipelines {
+		for _, ref := range p.InputRefs {
+			if internalobs.ReservedInputTypes.Has(ref) {
+				names.Insert(ref)
+			}
+		}
+	}
+	return names.List()
+}
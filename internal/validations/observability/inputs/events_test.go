@@ -0,0 +1,63 @@
+package inputs
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("#ValidateEvents", func() {
+
+	var (
+		input              obs.InputSpec
+		forwarder          obs.ClusterLogForwarderSpec
+		expConditionTypeRE = obs.ConditionTypeValidInputPrefix + "-.*"
+	)
+	BeforeEach(func() {
+		input = obs.InputSpec{
+			Name: "kube-events",
+			Type: obs.InputTypeEvents,
+			Events: &obs.EventsInput{
+				Type: obs.EventTypeWarning,
+			},
+		}
+		forwarder = obs.ClusterLogForwarderSpec{
+			Inputs: []obs.InputSpec{
+				input,
+				{
+					Name: "my-receiver",
+					Type: obs.InputTypeReceiver,
+				},
+			},
+			Pipelines: []obs.PipelineSpec{
+				{
+					Name:      "events",
+					InputRefs: []string{"kube-events", "my-receiver"},
+				},
+			},
+		}
+	})
+	It("should skip the validation when not an events type", func() {
+		input.Type = obs.InputTypeApplication
+		Expect(ValidateEvents(input, forwarder)).To(BeEmpty())
+	})
+	It("should fail when an events type but has no events spec", func() {
+		input.Events = nil
+		Expect(ValidateEvents(input, forwarder)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonMissingSpec, "kube-events has nil events spec"))
+	})
+	It("should pass for an events input without inputs collecting from the nodes", func() {
+		Expect(ValidateEvents(input, forwarder)).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+	})
+	It("should fail when combined with an input collecting from the nodes", func() {
+		forwarder.Inputs = append(forwarder.Inputs, obs.InputSpec{
+			Name: "my-files",
+			Type: obs.InputTypeHostFile,
+		})
+		Expect(ValidateEvents(input, forwarder)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `can not be combined.*my-files`))
+	})
+	It("should fail when a pipeline references a reserved input", func() {
+		forwarder.Pipelines[0].InputRefs = append(forwarder.Pipelines[0].InputRefs, string(obs.InputTypeAudit))
+		Expect(ValidateEvents(input, forwarder)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `can not be combined.*audit`))
+	})
+})
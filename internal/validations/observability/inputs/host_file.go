@@ -0,0 +1,91 @@
+package inputs
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// reservedHostFilePaths are the host directories of the container, journal and audit logs collected by the
+// application, infrastructure and audit inputs. /var/log/containers holds the links to the pod logs
+var reservedHostFilePaths = []string{
+	"/var/log/audit",
+	"/var/log/containers",
+	"/var/log/journal",
+	"/var/log/kube-apiserver",
+	"/var/log/oauth-apiserver",
+	"/var/log/openshift-apiserver",
+	"/var/log/ovn",
+	"/var/log/pods",
+}
+
+// ValidateHostFile validates host file input specs
+func ValidateHostFile(spec obs.InputSpec) []metav1.Condition {
+	if spec.Type != obs.InputTypeHostFile {
+		return nil
+	}
+	if spec.HostFile == nil {
+		return []metav1.Condition{
+			internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonMissingSpec, fmt.Sprintf("%s has nil hostFile spec", spec.Name)),
+		}
+	}
+	if len(spec.HostFile.Paths) == 0 {
+		return []metav1.Condition{
+			internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s requires at least one path", spec.Name)),
+		}
+	}
+	for _, path := range spec.HostFile.Paths {
+		if message := validateHostFilePath(path); message != "" {
+			return []metav1.Condition{
+				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s path %q %s", spec.Name, path, message)),
+			}
+		}
+	}
+	if multiline := spec.HostFile.Multiline; multiline != nil {
+		for _, pattern := range []string{multiline.StartPattern, multiline.ConditionPattern} {
+			if _, err := regexp.Compile(pattern); err != nil {
+				return []metav1.Condition{
+					internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s multiline pattern %q is not a valid regular expression", spec.Name, pattern)),
+				}
+			}
+		}
+	}
+	return []metav1.Condition{
+		internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, true, obs.ReasonValidationSuccess, fmt.Sprintf("input %q is valid", spec.Name)),
+	}
+}
+
+func validateHostFilePath(path string) string {
+	if !filepath.IsAbs(path) || filepath.Clean(path) != path {
+		return "must be an absolute and clean path"
+	}
+	if !strings.HasPrefix(path, internalobs.HostFileRootPath+"/") {
+		return fmt.Sprintf("must be under %s", internalobs.HostFileRootPath)
+	}
+	dir := internalobs.HostFileDirectory(path)
+	for _, reserved := range reservedHostFilePaths {
+		if dir == reserved || strings.HasPrefix(dir, reserved+"/") {
+			return "is collected by the application, infrastructure and audit inputs"
+		}
+		if strings.HasPrefix(reserved, dir+"/") && (strings.Contains(path, "**") || matchesDirectory(path, reserved)) {
+			return "may not match the logs collected by the application, infrastructure and audit inputs"
+		}
+	}
+	return ""
+}
+
+// matchesDirectory returns true when the leading segments of the path pattern match the directory
+func matchesDirectory(path, dir string) bool {
+	segments := strings.Split(path, "/")
+	depth := len(strings.Split(dir, "/"))
+	if len(segments) <= depth {
+		return false
+	}
+	matched, err := filepath.Match(strings.Join(segments[:depth], "/"), dir)
+	return err == nil && matched
+}
@@ -0,0 +1,72 @@
+package inputs
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("#ValidateHostFile", func() {
+
+	var (
+		input              obs.InputSpec
+		expConditionTypeRE = obs.ConditionTypeValidInputPrefix + "-.*"
+	)
+	BeforeEach(func() {
+		input = obs.InputSpec{
+			Name: "myfiles",
+			Type: obs.InputTypeHostFile,
+			HostFile: &obs.HostFileInput{
+				Paths: []string{"/var/log/acme/*.log", "/var/log/other/agent.log"},
+				Multiline: &obs.HostFileMultiline{
+					StartPattern:     `^\d{4}-\d{2}-\d{2}`,
+					ConditionPattern: `^\s+`,
+				},
+			},
+		}
+	})
+	It("should skip the validation when not a hostFile type", func() {
+		input.Type = obs.InputTypeApplication
+		Expect(ValidateHostFile(input)).To(BeEmpty())
+	})
+	It("should fail when a hostFile type but has no hostFile spec", func() {
+		input.HostFile = nil
+		Expect(ValidateHostFile(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonMissingSpec, "myfiles has nil hostFile spec"))
+	})
+	It("should pass for a valid hostFile input", func() {
+		Expect(ValidateHostFile(input)).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+	})
+	DescribeTable("should fail for paths outside of the allowed root", func(path, message string) {
+		input.HostFile.Paths = []string{path}
+		Expect(ValidateHostFile(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, message))
+	},
+		Entry("outside of /var/log", "/etc/acme/*.log", "must be under /var/log"),
+		Entry("escaping /var/log", "/var/log/../../etc/shadow", "must be an absolute and clean path"),
+		Entry("relative", "acme/*.log", "must be an absolute and clean path"),
+		Entry("of the pod logs", "/var/log/pods/*/*/*.log", "is collected by the application, infrastructure and audit inputs"),
+		Entry("of the container log links", "/var/log/containers/*.log", "is collected by the application, infrastructure and audit inputs"),
+		Entry("of the journal", "/var/log/journal/*", "is collected by the application, infrastructure and audit inputs"),
+		Entry("of the node audit logs", "/var/log/audit/audit.log", "is collected by the application, infrastructure and audit inputs"),
+		Entry("of the kube API server audit logs", "/var/log/kube-apiserver/audit.log", "is collected by the application, infrastructure and audit inputs"),
+		Entry("of the OpenShift API server audit logs", "/var/log/openshift-apiserver/audit.log", "is collected by the application, infrastructure and audit inputs"),
+		Entry("of the OAuth API server audit logs", "/var/log/oauth-apiserver/audit.log", "is collected by the application, infrastructure and audit inputs"),
+		Entry("of the OVN audit logs", "/var/log/ovn/acl-audit-log.log", "is collected by the application, infrastructure and audit inputs"),
+		Entry("in a sub directory of a reserved directory", "/var/log/ovn/old/*.log", "is collected by the application, infrastructure and audit inputs"),
+		Entry("recursively matching the pod logs", "/var/log/**/*.log", "may not match the logs collected"),
+		Entry("with a glob directory matching the API server logs", "/var/log/*-apiserver/audit.log", "may not match the logs collected"),
+		Entry("with a glob directory matching the audit logs", "/var/log/aud?t/*.log", "may not match the logs collected"),
+	)
+	DescribeTable("should pass for paths next to the reserved directories", func(path string) {
+		input.HostFile.Paths = []string{path}
+		Expect(ValidateHostFile(input)).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+	},
+		Entry("with a name prefixed by a reserved directory", "/var/log/audit-acme/*.log"),
+		Entry("with a glob directory not matching a reserved directory", "/var/log/acme-*/agent.log"),
+		Entry("with files in /var/log", "/var/log/*.log"),
+	)
+	It("should fail when a multiline pattern is not a valid regular expression", func() {
+		input.HostFile.Multiline.ConditionPattern = `^(\s+`
+		Expect(ValidateHostFile(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "multiline pattern .* is not a valid regular expression"))
+	})
+})
@@ -32,6 +32,12 @@ func ValidateInfrastructure(spec obs.InputSpec) []metav1.Condition {
 			internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s tuning section available only for \"container\" source type, but found %s", spec.Name, strSources)),
 		}
 	}
+	if len(spec.Infrastructure.Sources) > 0 && !set.New(spec.Infrastructure.Sources...).Has(obs.InfrastructureSourceNode) && spec.Infrastructure.Tuning != nil &&
+		spec.Infrastructure.Tuning.Journal != nil {
+		return []metav1.Condition{
+			internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s journal tuning section available only for \"node\" source type", spec.Name)),
+		}
+	}
 	return []metav1.Condition{
 		internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, true, obs.ReasonValidationSuccess, fmt.Sprintf("input %q is valid", spec.Name)),
 	}
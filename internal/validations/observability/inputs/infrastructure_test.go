@@ -80,4 +80,24 @@ var _ = Describe("#ValidateInfrastructure", func() {
 		}
 		Expect(ValidateInfrastructure(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "tuning section available only for \"container\" source type, but found node"))
 	})
+	It("should pass for valid infrastructure input with node source and journal tuning", func() {
+		input.Infrastructure = &obs.Infrastructure{
+			Sources: []obs.InfrastructureSource{obs.InfrastructureSourceNode},
+			Tuning: &obs.InfrastructureInputTuningSpec{
+				Journal: &obs.JournalInputTuningSpec{
+					ExcludeUnits: []string{"crio"},
+					MinPriority:  obs.JournalPriorityInfo,
+				},
+			},
+		}
+		Expect(ValidateInfrastructure(input)).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+	})
+	It("should fail for infrastructure input with container source and journal tuning", func() {
+		input.Infrastructure.Tuning = &obs.InfrastructureInputTuningSpec{
+			Journal: &obs.JournalInputTuningSpec{
+				ExcludeUnits: []string{"crio"},
+			},
+		}
+		Expect(ValidateInfrastructure(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "journal tuning section available only for \"node\" source type"))
+	})
 })
@@ -0,0 +1,60 @@
+package inputs
+
+import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/validations/observability/common"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ValidateKafka validates kafka input specs
+func ValidateKafka(spec obs.InputSpec, secrets map[string]*corev1.Secret, configMaps map[string]*corev1.ConfigMap) []metav1.Condition {
+	if spec.Type != obs.InputTypeKafka {
+		return nil
+	}
+	if spec.Kafka == nil {
+		return []metav1.Condition{
+			internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonMissingSpec, fmt.Sprintf("%s has nil kafka spec", spec.Name)),
+		}
+	}
+	if len(spec.Kafka.Brokers) == 0 || len(spec.Kafka.Topics) == 0 || spec.Kafka.GroupId == "" {
+		return []metav1.Condition{
+			internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s requires brokers, topics and a groupId", spec.Name)),
+		}
+	}
+	tlsBrokers := 0
+	for _, broker := range spec.Kafka.Brokers {
+		if strings.HasPrefix(string(broker), "tls:") {
+			tlsBrokers++
+		}
+	}
+	if tlsBrokers > 0 && tlsBrokers != len(spec.Kafka.Brokers) {
+		return []metav1.Condition{
+			internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s brokers must all use either the tcp or the tls scheme", spec.Name)),
+		}
+	}
+	if auth := spec.Kafka.Authentication; auth != nil && auth.SASL != nil && auth.SASL.OAuth != nil {
+		return []metav1.Condition{
+			internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s SASL authentication with oauth is only supported by outputs", spec.Name)),
+		}
+	}
+	keys := []*obs.ValueReference{}
+	for _, key := range internalobs.KafkaSASLKeys(spec.Kafka.Authentication) {
+		keys = append(keys, &obs.ValueReference{Key: key.Key, SecretName: key.SecretName})
+	}
+	if spec.Kafka.TLS != nil {
+		keys = append(keys, internalobs.ValueReferences(obs.TLSSpec(*spec.Kafka.TLS))...)
+	}
+	if messages := common.ValidateValueReference(keys, secrets, configMaps); len(messages) > 0 {
+		return []metav1.Condition{
+			internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, strings.Join(messages, ",")),
+		}
+	}
+	return []metav1.Condition{
+		internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, true, obs.ReasonValidationSuccess, fmt.Sprintf("input %q is valid", spec.Name)),
+	}
+}
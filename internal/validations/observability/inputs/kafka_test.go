@@ -0,0 +1,69 @@
+package inputs
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("#ValidateKafka", func() {
+
+	var (
+		input              obs.InputSpec
+		secrets            map[string]*corev1.Secret
+		configMaps         = map[string]*corev1.ConfigMap{}
+		expConditionTypeRE = obs.ConditionTypeValidInputPrefix + "-.*"
+	)
+	BeforeEach(func() {
+		secrets = map[string]*corev1.Secret{
+			"kafka-creds": runtime.NewSecret("", "kafka-creds", map[string][]byte{"username": []byte("user"), "password": []byte("pass")}),
+		}
+		input = obs.InputSpec{
+			Name: "mykafka",
+			Type: obs.InputTypeKafka,
+			Kafka: &obs.KafkaInput{
+				Brokers: []obs.BrokerURL{"tls://broker1:9093", "tls://broker2:9093"},
+				Topics:  []string{"app-logs"},
+				GroupId: "collector",
+				Authentication: &obs.KafkaAuthentication{
+					SASL: &obs.SASLAuthentication{
+						Username: &obs.SecretReference{Key: "username", SecretName: "kafka-creds"},
+						Password: &obs.SecretReference{Key: "password", SecretName: "kafka-creds"},
+					},
+				},
+			},
+		}
+	})
+	It("should skip the validation when not a kafka type", func() {
+		input.Type = obs.InputTypeApplication
+		Expect(ValidateKafka(input, secrets, configMaps)).To(BeEmpty())
+	})
+	It("should fail when a kafka type but has no kafka spec", func() {
+		input.Kafka = nil
+		Expect(ValidateKafka(input, secrets, configMaps)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonMissingSpec, "mykafka has nil kafka spec"))
+	})
+	It("should pass for a valid kafka input", func() {
+		Expect(ValidateKafka(input, secrets, configMaps)).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+	})
+	It("should fail when the brokers mix the tcp and tls schemes", func() {
+		input.Kafka.Brokers = []obs.BrokerURL{"tls://broker1:9093", "tcp://broker2:9092"}
+		Expect(ValidateKafka(input, secrets, configMaps)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "mykafka brokers must all use either the tcp or the tls scheme"))
+	})
+	It("should fail when the SASL authentication uses oauth", func() {
+		input.Kafka.Authentication.SASL = &obs.SASLAuthentication{
+			OAuth: &obs.SASLOAuthentication{
+				TokenURL:     "https://idp.example.com/oauth2/token",
+				ClientID:     &obs.SecretReference{Key: "username", SecretName: "kafka-creds"},
+				ClientSecret: &obs.SecretReference{Key: "password", SecretName: "kafka-creds"},
+			},
+		}
+		Expect(ValidateKafka(input, secrets, configMaps)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "mykafka SASL authentication with oauth is only supported by outputs"))
+	})
+	It("should fail when the SASL credentials are missing", func() {
+		delete(secrets, "kafka-creds")
+		Expect(ValidateKafka(input, secrets, configMaps)).To(Not(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, "")))
+	})
+})
@@ -41,6 +41,38 @@ func ValidateReceiver(spec obs.InputSpec, secrets map[string]*corev1.Secret, con
 			internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s does not specify a format", spec.Name)),
 		}
 	}
+	if spec.Receiver.Type == obs.ReceiverTypeHTTP && internalobs.ReservedReceiverSources.Has(spec.Receiver.HTTP.LogSource) {
+		return []metav1.Condition{
+			internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s logSource %q is reserved by the collector", spec.Name, spec.Receiver.HTTP.LogSource)),
+		}
+	}
+	if spec.Receiver.Type == obs.ReceiverTypeOTLP && internalobs.OTLPReceiverProtocol(spec.Receiver) == obs.OTLPReceiverProtocolBoth && spec.Receiver.OTLP.GRPCPort == 0 {
+		return []metav1.Condition{
+			internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s grpcPort is required when the protocol is both", spec.Name)),
+		}
+	}
+	if spec.Receiver.Type == obs.ReceiverTypeSyslog && internalobs.SyslogReceiverMode(spec.Receiver) != obs.SyslogReceiverModeTCP && spec.Receiver.TLS != nil {
+		return []metav1.Condition{
+			internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s TLS is only supported for a syslog receiver in tcp mode", spec.Name)),
+		}
+	}
+	if auth := spec.Receiver.Authentication; auth != nil {
+		if auth.Token != nil && spec.Receiver.Type != obs.ReceiverTypeHTTP {
+			return []metav1.Condition{
+				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s token authentication is only supported for the http receiver type", spec.Name)),
+			}
+		}
+		if spec.Receiver.Type == obs.ReceiverTypeSyslog && internalobs.SyslogReceiverMode(spec.Receiver) != obs.SyslogReceiverModeTCP {
+			return []metav1.Condition{
+				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s authentication is only supported for a syslog receiver in tcp mode", spec.Name)),
+			}
+		}
+		if messages := common.ValidateValueReference(internalobs.ReceiverAuthenticationReferences(auth), secrets, configMaps); len(messages) > 0 {
+			return []metav1.Condition{
+				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, strings.Join(messages, ",")),
+			}
+		}
+	}
 	if spec.Receiver.TLS != nil {
 		tlsSpec := obs.TLSSpec(*spec.Receiver.TLS)
 		keys := internalobs.ValueReferences(tlsSpec)
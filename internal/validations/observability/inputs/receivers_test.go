@@ -57,11 +57,140 @@ var _ = Describe("#ValidateReceiver", func() {
 			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
 			Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
 		})
+		It("should pass for a valid HTTP receiver spec of application logs", func() {
+			spec.Receiver.Type = obs.ReceiverTypeHTTP
+			spec.Receiver.HTTP = &obs.HTTPReceiver{
+				Format:    obs.HTTPReceiverFormatNDJSON,
+				LogSource: "myagent",
+			}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+		})
+		It("should fail when an HTTP receiver of application logs uses a reserved log source", func() {
+			spec.Receiver.Type = obs.ReceiverTypeHTTP
+			spec.Receiver.HTTP = &obs.HTTPReceiver{
+				Format:    obs.HTTPReceiverFormatJSON,
+				LogSource: string(obs.ApplicationSourceContainer),
+			}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `myreceiver logSource "container" is reserved by the collector`))
+		})
 		It("should pass for a valid syslog receiver spec", func() {
 			spec.Receiver.Type = obs.ReceiverTypeSyslog
 			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
 			Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
 		})
+		It("should pass for a valid syslog receiver spec in udp mode", func() {
+			spec.Receiver.Type = obs.ReceiverTypeSyslog
+			spec.Receiver.Syslog = &obs.SyslogReceiver{Mode: obs.SyslogReceiverModeUDP}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+		})
+		It("should fail when a syslog receiver in udp mode specifies TLS", func() {
+			spec.Receiver.Type = obs.ReceiverTypeSyslog
+			spec.Receiver.Syslog = &obs.SyslogReceiver{Mode: obs.SyslogReceiverModeUDP}
+			spec.Receiver.TLS = &obs.InputTLSSpec{
+				Key: &obs.SecretReference{
+					Key:        "tls.key",
+					SecretName: "mysecret",
+				},
+			}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "myreceiver TLS is only supported for a syslog receiver in tcp mode"))
+		})
+		It("should fail when a syslog receiver in both mode specifies TLS", func() {
+			spec.Receiver.Type = obs.ReceiverTypeSyslog
+			spec.Receiver.Syslog = &obs.SyslogReceiver{Mode: obs.SyslogReceiverModeBoth}
+			spec.Receiver.TLS = &obs.InputTLSSpec{
+				Key: &obs.SecretReference{
+					Key:        "tls.key",
+					SecretName: "mysecret",
+				},
+			}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "myreceiver TLS is only supported for a syslog receiver in tcp mode"))
+		})
+		It("should pass for a syslog receiver in both mode after the forwarder is initialized", func() {
+			spec.Receiver.Type = obs.ReceiverTypeSyslog
+			spec.Receiver.Syslog = &obs.SyslogReceiver{Mode: obs.SyslogReceiverModeBoth}
+			forwarder := obs.ClusterLogForwarder{
+				Spec: obs.ClusterLogForwarderSpec{
+					Inputs: []obs.InputSpec{spec},
+				},
+			}
+			forwarder.Name = "myforwarder"
+			context := utils.Options{}
+			forwarder = initialize.ClusterLogForwarder(forwarder, context)
+			Expect(forwarder.Spec.Inputs).To(HaveLen(1))
+			conds := ValidateReceiver(forwarder.Spec.Inputs[0], secrets, configMaps, context)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+		})
+		It("should pass for a syslog receiver in udp mode after the forwarder is initialized", func() {
+			spec.Receiver.Type = obs.ReceiverTypeSyslog
+			spec.Receiver.Syslog = &obs.SyslogReceiver{Mode: obs.SyslogReceiverModeUDP}
+			forwarder := obs.ClusterLogForwarder{
+				Spec: obs.ClusterLogForwarderSpec{
+					Inputs: []obs.InputSpec{spec},
+				},
+			}
+			forwarder.Name = "myforwarder"
+			context := utils.Options{}
+			forwarder = initialize.ClusterLogForwarder(forwarder, context)
+			Expect(forwarder.Spec.Inputs).To(HaveLen(1))
+			conds := ValidateReceiver(forwarder.Spec.Inputs[0], secrets, configMaps, context)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+		})
+		It("should pass for a valid OTLP receiver spec", func() {
+			spec.Receiver.Type = obs.ReceiverTypeOTLP
+			spec.Receiver.OTLP = &obs.OTLPReceiver{Protocol: obs.OTLPReceiverProtocolBoth, GRPCPort: 4317}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+		})
+		It("should fail when an OTLP receiver listening on both protocols does not specify a grpcPort", func() {
+			spec.Receiver.Type = obs.ReceiverTypeOTLP
+			spec.Receiver.OTLP = &obs.OTLPReceiver{Protocol: obs.OTLPReceiverProtocolBoth}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "myreceiver grpcPort is required when the protocol is both"))
+		})
+		It("should fail when a receiver other than http specifies token authentication", func() {
+			spec.Receiver.Type = obs.ReceiverTypeOTLP
+			spec.Receiver.Authentication = &obs.ReceiverAuthentication{
+				Token: &obs.SecretReference{Key: "token", SecretName: "mytokens"},
+			}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "myreceiver token authentication is only supported for the http receiver type"))
+		})
+		It("should fail when a syslog receiver listening on udp specifies authentication", func() {
+			spec.Receiver.Type = obs.ReceiverTypeSyslog
+			spec.Receiver.Syslog = &obs.SyslogReceiver{Mode: obs.SyslogReceiverModeBoth}
+			spec.Receiver.Authentication = &obs.ReceiverAuthentication{
+				ClientCA: &obs.ValueReference{Key: "ca.crt", ConfigMapName: "clients"},
+			}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "myreceiver authentication is only supported for a syslog receiver in tcp mode"))
+		})
+		It("should fail when the authentication references a missing secret", func() {
+			spec.Receiver.Type = obs.ReceiverTypeHTTP
+			spec.Receiver.HTTP = &obs.HTTPReceiver{Format: obs.HTTPReceiverFormatKubeAPIAudit}
+			spec.Receiver.Authentication = &obs.ReceiverAuthentication{
+				Token: &obs.SecretReference{Key: "token", SecretName: "immissing"},
+			}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(Not(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, "")))
+		})
+		It("should pass for an HTTP receiver with mTLS and token authentication", func() {
+			spec.Receiver.Type = obs.ReceiverTypeHTTP
+			spec.Receiver.HTTP = &obs.HTTPReceiver{Format: obs.HTTPReceiverFormatKubeAPIAudit}
+			spec.Receiver.Authentication = &obs.ReceiverAuthentication{
+				ClientCA: &obs.ValueReference{Key: "ca.crt", ConfigMapName: "clients"},
+				Token:    &obs.SecretReference{Key: "token", SecretName: "mytokens"},
+			}
+			conds := ValidateReceiver(spec,
+				map[string]*corev1.Secret{"mytokens": runtime.NewSecret("", "mytokens", map[string][]byte{"token": []byte("abc")})},
+				map[string]*corev1.ConfigMap{"clients": runtime.NewConfigMap("", "clients", map[string]string{"ca.crt": "-- ca --"})},
+				utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+		})
 		It("should fail validate secrets if spec'd", func() {
 			spec.Receiver.Type = obs.ReceiverTypeSyslog
 			spec.Receiver.TLS = &obs.InputTLSSpec{
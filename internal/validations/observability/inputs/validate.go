@@ -20,6 +20,12 @@ func Validate(context internalcontext.ForwarderContext) {
 			conditions = ValidateAudit(i)
 		case obs.InputTypeReceiver:
 			conditions = ValidateReceiver(i, context.Secrets, context.ConfigMaps, context.AdditionalContext)
+		case obs.InputTypeKafka:
+			conditions = ValidateKafka(i, context.Secrets, context.ConfigMaps)
+		case obs.InputTypeHostFile:
+			conditions = ValidateHostFile(i)
+		case obs.InputTypeEvents:
+			conditions = ValidateEvents(i, context.Forwarder.Spec)
 		}
 		results = append(results, conditions...)
 	}
@@ -28,12 +28,16 @@ func Validate(context internalcontext.ForwarderContext) {
 			if out.Type == obs.OutputTypeCloudwatch {
 				messages = append(messages, validateCloudwatchMaxWrite(out)...)
 			}
-		case obs.OutputTypeGoogleCloudLogging:
+		case obs.OutputTypeGoogleCloudLogging, obs.OutputTypeGoogleCloudStorage:
 			messages = append(messages, ValidateGCLAuth(out, context)...)
 		case obs.OutputTypeHTTP:
 			messages = append(messages, validateHttpContentTypeHeaders(out)...)
 		case obs.OutputTypeElasticsearch:
 			messages = append(messages, validateElasticsearchHeaders(out)...)
+			messages = append(messages, validateElasticsearchDataStream(out)...)
+		case obs.OutputTypeKafka:
+			messages = append(messages, validateKafkaLibrdKafkaOptions(out)...)
+			messages = append(messages, validateKafkaSASL(out)...)
 		case obs.OutputTypeAzureLogsIngestion:
 			messages = append(messages, validateAzureLogsIngestionMaxWrite(out)...)
 		}
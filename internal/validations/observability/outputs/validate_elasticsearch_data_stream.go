@@ -0,0 +1,27 @@
+package outputs
+
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+// validateElasticsearchDataStream will validate the Elasticsearch data stream is not combined with options
+// Elasticsearch does not accept for data streams
+// data streams only accept the create bulk action
+// data streams are not supported by Elasticsearch version 6
+// data streams are written instead of the index
+func validateElasticsearchDataStream(output obs.OutputSpec) (results []string) {
+	if output.Type != obs.OutputTypeElasticsearch || output.Elasticsearch == nil || output.Elasticsearch.DataStream == nil {
+		return results
+	}
+	es := output.Elasticsearch
+	if es.BulkAction == obs.ElasticsearchBulkActionIndex {
+		results = append(results, "data streams only accept the create bulk action")
+	}
+	if es.Version < 7 {
+		results = append(results, "data streams require Elasticsearch version 7 or later")
+	}
+	if es.Index != "" {
+		results = append(results, "index must not be set with dataStream")
+	}
+	return results
+}
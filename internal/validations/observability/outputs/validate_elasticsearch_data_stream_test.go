@@ -0,0 +1,57 @@
+package outputs
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+var _ = Describe("[internal][validations] ClusterLogForwarder will validate the data stream of Elasticsearch outputs", func() {
+	var (
+		spec obs.OutputSpec
+	)
+	BeforeEach(func() {
+		spec = obs.OutputSpec{
+			Name: "esOutput",
+			Type: obs.OutputTypeElasticsearch,
+			Elasticsearch: &obs.Elasticsearch{
+				URLSpec: obs.URLSpec{URL: "https://es.example.com:9200"},
+				Version: 8,
+				DataStream: &obs.ElasticsearchDataStream{
+					Dataset: `{.log_type||"none"}`,
+				},
+			},
+		}
+	})
+
+	Context("#validateElasticsearchDataStream", func() {
+
+		It("should pass validation without a data stream", func() {
+			spec.Elasticsearch.DataStream = nil
+			spec.Elasticsearch.Index = "app-write"
+			spec.Elasticsearch.BulkAction = obs.ElasticsearchBulkActionIndex
+			Expect(validateElasticsearchDataStream(spec)).To(BeEmpty())
+		})
+
+		It("should pass validation for a data stream with the create action", func() {
+			spec.Elasticsearch.BulkAction = obs.ElasticsearchBulkActionCreate
+			spec.Elasticsearch.DocumentID = `{.kubernetes.pod_id||"none"}`
+			Expect(validateElasticsearchDataStream(spec)).To(BeEmpty())
+		})
+
+		It("should fail validation for a data stream with the index action", func() {
+			spec.Elasticsearch.BulkAction = obs.ElasticsearchBulkActionIndex
+			Expect(validateElasticsearchDataStream(spec)).To(ConsistOf("data streams only accept the create bulk action"))
+		})
+
+		It("should fail validation for a data stream with Elasticsearch version 6", func() {
+			spec.Elasticsearch.Version = 6
+			Expect(validateElasticsearchDataStream(spec)).To(ConsistOf("data streams require Elasticsearch version 7 or later"))
+		})
+
+		It("should fail validation for a data stream with an index", func() {
+			spec.Elasticsearch.Index = "app-write"
+			Expect(validateElasticsearchDataStream(spec)).To(ConsistOf("index must not be set with dataStream"))
+		})
+	})
+})
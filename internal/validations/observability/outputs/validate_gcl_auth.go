@@ -26,10 +26,10 @@ type gcpCredentialSource struct {
 }
 
 func ValidateGCLAuth(o obs.OutputSpec, context internalcontext.ForwarderContext) (results []string) {
-	if o.GoogleCloudLogging == nil || o.GoogleCloudLogging.Authentication == nil {
+	auth := gcpAuthentication(o)
+	if auth == nil {
 		return results
 	}
-	auth := o.GoogleCloudLogging.Authentication
 	secrets := observability.Secrets(context.Secrets)
 
 	if auth.Credentials == nil {
@@ -53,6 +53,17 @@ func ValidateGCLAuth(o obs.OutputSpec, context internalcontext.ForwarderContext)
 	return results
 }
 
+// gcpAuthentication returns the GCP authentication of the Google Cloud Logging and Google Cloud Storage outputs
+func gcpAuthentication(o obs.OutputSpec) *obs.GoogleCloudLoggingAuthentication {
+	switch {
+	case o.Type == obs.OutputTypeGoogleCloudLogging && o.GoogleCloudLogging != nil:
+		return o.GoogleCloudLogging.Authentication
+	case o.Type == obs.OutputTypeGoogleCloudStorage && o.GoogleCloudStorage != nil:
+		return o.GoogleCloudStorage.Authentication
+	}
+	return nil
+}
+
 func validateGCLExternalAccount(creds *gcpCredentialFile, token *obs.BearerToken) (results []string) {
 	if creds.CredentialSource == nil {
 		return append(results, "GCP external account credentials missing required field \"credential_source\"")
@@ -139,6 +139,31 @@ var _ = Describe("validating GCL auth", func() {
 		})
 	})
 
+	Context("googleCloudStorage output", func() {
+		gcsSpec := obs.OutputSpec{
+			Name: "gcs-output",
+			Type: obs.OutputTypeGoogleCloudStorage,
+			GoogleCloudStorage: &obs.GoogleCloudStorage{
+				Authentication: workloadIdentitySpec.GoogleCloudLogging.Authentication,
+			},
+		}
+
+		It("should pass with valid external_account credentials and token", func() {
+			ctx := makeContext(gcsSpec, makeSecret(validExternalAccount))
+			Expect(ValidateGCLAuth(gcsSpec, ctx)).To(BeEmpty())
+		})
+
+		It("should fail when credential_source.file does not match expected token path", func() {
+			creds := validExternalAccount
+			creds.CredentialSource = &gcpCredentialSource{
+				File: "/wrong/path/token",
+			}
+			ctx := makeContext(gcsSpec, makeSecret(creds))
+			res := ValidateGCLAuth(gcsSpec, ctx)
+			Expect(res).To(ContainElement(ContainSubstring("does not match expected token path")))
+		})
+	})
+
 	Context("unsupported credentials type", func() {
 		It("should fail with unsupported type", func() {
 			creds := gcpCredentialFile{
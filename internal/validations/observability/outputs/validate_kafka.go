@@ -0,0 +1,90 @@
+package outputs
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+)
+
+// librdKafkaOptionRange is the range of the values of a numeric librdkafka option
+type librdKafkaOptionRange struct {
+	min, max float64
+	fraction bool
+}
+
+// librdKafkaOptionRanges are the ranges of the numeric librdkafka options which may be tuned, as documented by
+// librdkafka
+var librdKafkaOptionRanges = map[string]librdKafkaOptionRange{
+	"linger.ms":                    {min: 0, max: 900000, fraction: true},
+	"batch.size":                   {min: 1, max: math.MaxInt32},
+	"batch.num.messages":           {min: 1, max: 1000000},
+	"queue.buffering.max.messages": {min: 0, max: math.MaxInt32},
+	"queue.buffering.max.kbytes":   {min: 1, max: math.MaxInt32},
+	"request.timeout.ms":           {min: 1, max: 900000},
+}
+
+// validateKafkaLibrdKafkaOptions validates the librdkafka options are allowed to be tuned, their values are within
+// the ranges of librdkafka and the acks do not weaken the delivery mode of the output
+func validateKafkaLibrdKafkaOptions(output obs.OutputSpec) (results []string) {
+	if output.Type != obs.OutputTypeKafka || output.Kafka == nil || output.Kafka.Tuning == nil {
+		return results
+	}
+	tuning := output.Kafka.Tuning
+	for _, name := range slices.Sorted(maps.Keys(tuning.LibrdKafkaOptions)) {
+		value := tuning.LibrdKafkaOptions[name]
+		switch {
+		case !slices.Contains(internalobs.AllowedLibrdKafkaOptions, name):
+			results = append(results, fmt.Sprintf("librdkafka option %q is not allowed", name))
+		case name == "acks":
+			if !slices.Contains([]string{"all", "-1", "1"}, value) {
+				results = append(results, fmt.Sprintf("librdkafka option \"acks\" must be one of all, -1 or 1, got %q", value))
+			} else if tuning.DeliveryMode == obs.DeliveryModeAtLeastOnce && value == "1" {
+				results = append(results, fmt.Sprintf("librdkafka option \"acks\" must be all or -1 for delivery mode %s", obs.DeliveryModeAtLeastOnce))
+			}
+		default:
+			r := librdKafkaOptionRanges[name]
+			n, err := strconv.ParseFloat(value, 64)
+			if !r.fraction && err == nil {
+				_, err = strconv.ParseInt(value, 10, 64)
+			}
+			if err != nil || math.IsNaN(n) || n < r.min || n > r.max {
+				kind := "an integer"
+				if r.fraction {
+					kind = "a number"
+				}
+				results = append(results, fmt.Sprintf("librdkafka option %q must be %s from %.0f to %.0f, got %q", name, kind, r.min, r.max, value))
+			}
+		}
+	}
+	return results
+}
+
+// validateKafkaSASL validates only one SASL authentication mode is configured for a Kafka output and the mechanism
+// matches the mode. The AWS_MSK_IAM mechanism is rejected because librdkafka, the Kafka client of the collector,
+// implements neither the mechanism nor the signing of MSK IAM tokens for OAUTHBEARER. Supporting it requires a token
+// provider signing the tokens next to the collector, which is a follow-up
+func validateKafkaSASL(output obs.OutputSpec) (results []string) {
+	if output.Type != obs.OutputTypeKafka || output.Kafka == nil || output.Kafka.Authentication == nil || output.Kafka.Authentication.SASL == nil {
+		return results
+	}
+	sasl := output.Kafka.Authentication.SASL
+	if (sasl.Username != nil || sasl.Password != nil) && sasl.OAuth != nil {
+		return append(results, "only one of username and password or oauth may be set for SASL authentication")
+	}
+	switch {
+	case sasl.Mechanism == internalobs.KafkaSASLMechanismAwsMskIam:
+		results = append(results, fmt.Sprintf("SASL mechanism %s is not supported, use SCRAM-SHA-512 or TLS client authentication with Amazon MSK", internalobs.KafkaSASLMechanismAwsMskIam))
+	case sasl.OAuth != nil:
+		if sasl.Mechanism != "" && sasl.Mechanism != internalobs.KafkaSASLMechanismOAuthBearer {
+			results = append(results, fmt.Sprintf("SASL mechanism must be %s when oauth is set", internalobs.KafkaSASLMechanismOAuthBearer))
+		}
+	case sasl.Mechanism == internalobs.KafkaSASLMechanismOAuthBearer:
+		results = append(results, fmt.Sprintf("oauth must be set for SASL mechanism %s", internalobs.KafkaSASLMechanismOAuthBearer))
+	}
+	return results
+}
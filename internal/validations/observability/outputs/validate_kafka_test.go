@@ -0,0 +1,163 @@
+package outputs
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+var _ = Describe("[internal][validations] ClusterLogForwarder will validate the librdkafka options of Kafka outputs", func() {
+	var (
+		spec obs.OutputSpec
+	)
+	BeforeEach(func() {
+		spec = obs.OutputSpec{
+			Name: "kafkaOutput",
+			Type: obs.OutputTypeKafka,
+			Kafka: &obs.Kafka{
+				Tuning: &obs.KafkaTuningSpec{},
+			},
+		}
+	})
+
+	Context("#validateKafkaLibrdKafkaOptions", func() {
+
+		It("should pass validation when no tuning is set", func() {
+			spec.Kafka.Tuning = nil
+			Expect(validateKafkaLibrdKafkaOptions(spec)).To(BeEmpty())
+		})
+
+		It("should pass validation for allowed options", func() {
+			spec.Kafka.Tuning.LibrdKafkaOptions = map[string]string{
+				"acks":               "1",
+				"linger.ms":          "50",
+				"batch.size":         "1000000",
+				"batch.num.messages": "10000",
+			}
+			Expect(validateKafkaLibrdKafkaOptions(spec)).To(BeEmpty())
+		})
+
+		It("should fail validation for options which are not allowed", func() {
+			spec.Kafka.Tuning.LibrdKafkaOptions = map[string]string{
+				"enable.ssl.certificate.verification": "false",
+				"message.max.bytes":                   "100",
+			}
+			Expect(validateKafkaLibrdKafkaOptions(spec)).To(ConsistOf(
+				`librdkafka option "enable.ssl.certificate.verification" is not allowed`,
+				`librdkafka option "message.max.bytes" is not allowed`,
+			))
+		})
+
+		It("should fail validation when acks are disabled", func() {
+			spec.Kafka.Tuning.LibrdKafkaOptions = map[string]string{"acks": "0"}
+			Expect(validateKafkaLibrdKafkaOptions(spec)).To(HaveLen(1))
+		})
+
+		It("should fail validation when acks are weaker than the AtLeastOnce delivery mode", func() {
+			spec.Kafka.Tuning.DeliveryMode = obs.DeliveryModeAtLeastOnce
+			spec.Kafka.Tuning.LibrdKafkaOptions = map[string]string{"acks": "1"}
+			Expect(validateKafkaLibrdKafkaOptions(spec)).To(HaveLen(1))
+
+			spec.Kafka.Tuning.LibrdKafkaOptions = map[string]string{"acks": "all"}
+			Expect(validateKafkaLibrdKafkaOptions(spec)).To(BeEmpty())
+		})
+
+		DescribeTable("should validate the values are within the ranges of librdkafka", func(name, value, message string) {
+			spec.Kafka.Tuning.LibrdKafkaOptions = map[string]string{name: value}
+			if message == "" {
+				Expect(validateKafkaLibrdKafkaOptions(spec)).To(BeEmpty())
+			} else {
+				Expect(validateKafkaLibrdKafkaOptions(spec)).To(ConsistOf(message))
+			}
+		},
+			Entry("linger.ms at the minimum", "linger.ms", "0", ""),
+			Entry("linger.ms as a fraction", "linger.ms", "0.5", ""),
+			Entry("linger.ms at the maximum", "linger.ms", "900000", ""),
+			Entry("linger.ms above the maximum", "linger.ms", "900000.5", `librdkafka option "linger.ms" must be a number from 0 to 900000, got "900000.5"`),
+			Entry("linger.ms below the minimum", "linger.ms", "-0.5", `librdkafka option "linger.ms" must be a number from 0 to 900000, got "-0.5"`),
+			Entry("linger.ms not a number", "linger.ms", "NaN", `librdkafka option "linger.ms" must be a number from 0 to 900000, got "NaN"`),
+			Entry("linger.ms infinite", "linger.ms", "Inf", `librdkafka option "linger.ms" must be a number from 0 to 900000, got "Inf"`),
+			Entry("linger.ms with a unit", "linger.ms", "5ms", `librdkafka option "linger.ms" must be a number from 0 to 900000, got "5ms"`),
+			Entry("batch.num.messages at the minimum", "batch.num.messages", "1", ""),
+			Entry("batch.num.messages below the minimum", "batch.num.messages", "0", `librdkafka option "batch.num.messages" must be an integer from 1 to 1000000, got "0"`),
+			Entry("batch.num.messages at the maximum", "batch.num.messages", "1000000", ""),
+			Entry("batch.num.messages above the maximum", "batch.num.messages", "1000001", `librdkafka option "batch.num.messages" must be an integer from 1 to 1000000, got "1000001"`),
+			Entry("batch.size at the minimum", "batch.size", "1", ""),
+			Entry("batch.size below the minimum", "batch.size", "0", `librdkafka option "batch.size" must be an integer from 1 to 2147483647, got "0"`),
+			Entry("batch.size at the maximum", "batch.size", "2147483647", ""),
+			Entry("batch.size above the maximum", "batch.size", "2147483648", `librdkafka option "batch.size" must be an integer from 1 to 2147483647, got "2147483648"`),
+			Entry("batch.size with a unit", "batch.size", "1MB", `librdkafka option "batch.size" must be an integer from 1 to 2147483647, got "1MB"`),
+			Entry("batch.size as a fraction", "batch.size", "1.5", `librdkafka option "batch.size" must be an integer from 1 to 2147483647, got "1.5"`),
+			Entry("queue.buffering.max.messages at the minimum", "queue.buffering.max.messages", "0", ""),
+			Entry("queue.buffering.max.messages below the minimum", "queue.buffering.max.messages", "-1", `librdkafka option "queue.buffering.max.messages" must be an integer from 0 to 2147483647, got "-1"`),
+			Entry("queue.buffering.max.kbytes at the minimum", "queue.buffering.max.kbytes", "1", ""),
+			Entry("queue.buffering.max.kbytes below the minimum", "queue.buffering.max.kbytes", "0", `librdkafka option "queue.buffering.max.kbytes" must be an integer from 1 to 2147483647, got "0"`),
+			Entry("request.timeout.ms at the minimum", "request.timeout.ms", "1", ""),
+			Entry("request.timeout.ms below the minimum", "request.timeout.ms", "0", `librdkafka option "request.timeout.ms" must be an integer from 1 to 900000, got "0"`),
+			Entry("request.timeout.ms at the maximum", "request.timeout.ms", "900000", ""),
+			Entry("request.timeout.ms above the maximum", "request.timeout.ms", "900001", `librdkafka option "request.timeout.ms" must be an integer from 1 to 900000, got "900001"`),
+		)
+	})
+})
+
+var _ = Describe("[internal][validations] ClusterLogForwarder will validate the SASL authentication of Kafka outputs", func() {
+	var (
+		spec  obs.OutputSpec
+		oauth = &obs.SASLOAuthentication{
+			TokenURL:     "https://idp.example.com/oauth2/token",
+			ClientID:     &obs.SecretReference{Key: "client_id", SecretName: "kafka"},
+			ClientSecret: &obs.SecretReference{Key: "client_secret", SecretName: "kafka"},
+		}
+	)
+	BeforeEach(func() {
+		spec = obs.OutputSpec{
+			Name: "kafkaOutput",
+			Type: obs.OutputTypeKafka,
+			Kafka: &obs.Kafka{
+				URL: "tls://broker1-kafka.svc.messaging.cluster.local:9093/topic",
+				Authentication: &obs.KafkaAuthentication{
+					SASL: &obs.SASLAuthentication{},
+				},
+			},
+		}
+	})
+
+	Context("#validateKafkaSASL", func() {
+
+		It("should pass validation for username and password", func() {
+			spec.Kafka.Authentication.SASL.Username = &obs.SecretReference{Key: "username", SecretName: "kafka"}
+			spec.Kafka.Authentication.SASL.Password = &obs.SecretReference{Key: "password", SecretName: "kafka"}
+			spec.Kafka.Authentication.SASL.Mechanism = "SCRAM-SHA-512"
+			Expect(validateKafkaSASL(spec)).To(BeEmpty())
+		})
+
+		It("should pass validation for oauth", func() {
+			spec.Kafka.Authentication.SASL.OAuth = oauth
+			Expect(validateKafkaSASL(spec)).To(BeEmpty())
+		})
+
+		It("should fail validation when several modes are set", func() {
+			spec.Kafka.Authentication.SASL.Username = &obs.SecretReference{Key: "username", SecretName: "kafka"}
+			spec.Kafka.Authentication.SASL.OAuth = oauth
+			Expect(validateKafkaSASL(spec)).To(ConsistOf("only one of username and password or oauth may be set for SASL authentication"))
+		})
+
+		It("should fail validation when the mechanism does not match the mode", func() {
+			spec.Kafka.Authentication.SASL.OAuth = oauth
+			spec.Kafka.Authentication.SASL.Mechanism = "PLAIN"
+			Expect(validateKafkaSASL(spec)).To(ConsistOf("SASL mechanism must be OAUTHBEARER when oauth is set"))
+		})
+
+		It("should fail validation when the mechanism requires a missing mode", func() {
+			spec.Kafka.Authentication.SASL.Mechanism = "OAUTHBEARER"
+			Expect(validateKafkaSASL(spec)).To(ConsistOf("oauth must be set for SASL mechanism OAUTHBEARER"))
+		})
+
+		It("should fail validation for the AWS_MSK_IAM mechanism", func() {
+			spec.Kafka.Authentication.SASL.Username = &obs.SecretReference{Key: "username", SecretName: "kafka"}
+			spec.Kafka.Authentication.SASL.Password = &obs.SecretReference{Key: "password", SecretName: "kafka"}
+			spec.Kafka.Authentication.SASL.Mechanism = "AWS_MSK_IAM"
+			Expect(validateKafkaSASL(spec)).To(ConsistOf("SASL mechanism AWS_MSK_IAM is not supported, use SCRAM-SHA-512 or TLS client authentication with Amazon MSK"))
+		})
+	})
+})
@@ -5,6 +5,7 @@ import (
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
 	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/validations/observability/filters"
 	"strings"
 )
 
@@ -18,7 +19,9 @@ func Validate(context internalcontext.ForwarderContext) {
 		if len(refMessages) > 0 {
 			messages = append(messages, fmt.Sprintf("refs not found: %s", strings.Join(refMessages, ",")))
 		}
+		messages = append(messages, validateRoutes(pipelineSpec)...)
 		messages = append(messages, verifyHostNameNotFilteredForGCL(pipelineSpec, outputs, filters)...)
+		messages = append(messages, verifyKubernetesAttributesNotForwardedToLokiStack(pipelineSpec, inputs, outputs)...)
 		if len(messages) > 0 {
 			internalobs.SetCondition(&context.Forwarder.Status.PipelineConditions,
 				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidPipelinePrefix, pipelineSpec.Name, false, obs.ReasonValidationFailure, strings.Join(messages, ",")))
@@ -53,6 +56,18 @@ func validateRef(pipeline obs.PipelineSpec, inputs map[string]obs.InputSpec, out
 		results = append(results, fmt.Sprintf("outputs%v", outputRefs))
 	}
 
+	for _, route := range pipeline.Routes {
+		var routeOutputRefs []string
+		for _, ref := range route.OutputRefs {
+			if _, found := outputs[ref]; !found {
+				routeOutputRefs = append(routeOutputRefs, ref)
+			}
+		}
+		if len(routeOutputRefs) > 0 {
+			results = append(results, fmt.Sprintf("route %q outputs%v", route.Name, routeOutputRefs))
+		}
+	}
+
 	var filterRefs []string
 	for _, ref := range pipeline.FilterRefs {
 		if _, found := filters[ref]; !found {
@@ -65,13 +80,24 @@ func validateRef(pipeline obs.PipelineSpec, inputs map[string]obs.InputSpec, out
 	return results
 }
 
+// validateRoutes validates the conditions of the tests of each route
+func validateRoutes(pipeline obs.PipelineSpec) (results []string) {
+	for _, route := range pipeline.Routes {
+		if len(route.Tests) == 0 {
+			results = append(results, fmt.Sprintf("route %q must have at least one test spec'd", route.Name))
+		}
+		results = append(results, filters.ValidateDropTests(fmt.Sprintf("route %q", route.Name), route.Tests)...)
+	}
+	return results
+}
+
 // verifyHostNameNotFilteredForGCL verifies that within a pipeline featuring a GCL sink and prune filters, the `.hostname` field is exempted from pruning.
 func verifyHostNameNotFilteredForGCL(pipeline obs.PipelineSpec, outputs map[string]obs.OutputSpec, filters map[string]*obs.FilterSpec) (results []string) {
 	if len(pipeline.FilterRefs) == 0 {
 		return nil
 	}
 
-	for _, out := range pipeline.OutputRefs {
+	for _, out := range internalobs.OutputRefs(pipeline) {
 		if output, exists := outputs[out]; exists && output.Type == obs.OutputTypeGoogleCloudLogging {
 			for _, f := range pipeline.FilterRefs {
 				if filterSpec, ok := filters[f]; ok && prunesHostName(*filterSpec) {
@@ -83,6 +109,24 @@ func verifyHostNameNotFilteredForGCL(pipeline obs.PipelineSpec, outputs map[stri
 	return results
 }
 
+// verifyKubernetesAttributesNotForwardedToLokiStack verifies OTLP receivers which map the kubernetes attributes supplied by
+// the sender are not forwarded to LokiStack outputs, which identify the tenant of the logs by the namespace
+func verifyKubernetesAttributesNotForwardedToLokiStack(pipeline obs.PipelineSpec, inputs map[string]obs.InputSpec, outputs map[string]obs.OutputSpec) (results []string) {
+	for _, in := range pipeline.InputRefs {
+		input, exists := inputs[in]
+		if !exists || input.Type != obs.InputTypeReceiver || input.Receiver == nil || input.Receiver.Type != obs.ReceiverTypeOTLP ||
+			input.Receiver.OTLP == nil || !input.Receiver.OTLP.MapKubernetesAttributes {
+			continue
+		}
+		for _, out := range internalobs.OutputRefs(pipeline) {
+			if output, exists := outputs[out]; exists && output.Type == obs.OutputTypeLokiStack {
+				results = append(results, fmt.Sprintf("input %q maps the kubernetes attributes of the sender which can not be forwarded to output %q of type %q", input.Name, output.Name, output.Type))
+			}
+		}
+	}
+	return results
+}
+
 // prunesHostName checks if a prune filter prunes the `.hostname` field
 func prunesHostName(filter obs.FilterSpec) bool {
 	if filter.Type != obs.FilterTypePrune {
@@ -47,4 +47,75 @@ var _ = Describe("Pipeline validation #validateRef", func() {
 		Expect(cond).To(BeEmpty())
 	})
 
+	It("should fail when a route output does not exist", func() {
+		pipelineSpec := initSpec()
+		pipelineSpec.Routes = []obs.PipelineRoute{
+			{Name: "errors", OutputRefs: []string{"anOutput", "missing"}},
+		}
+		cond := validateRef(pipelineSpec, inputMap, outputMap, filterMap)
+		Expect(cond).To(ConsistOf(`route "errors" outputs[missing]`))
+	})
+
+})
+
+var _ = Describe("Pipeline validation #validateRoutes", func() {
+
+	DescribeTable("routes", func(tests []obs.DropTest, messageRE string) {
+		pipelineSpec := obs.PipelineSpec{
+			Name: "myPipeline",
+			Routes: []obs.PipelineRoute{
+				{Name: "errors", Tests: tests, OutputRefs: []string{"anOutput"}},
+			},
+		}
+		results := validateRoutes(pipelineSpec)
+		if messageRE == "" {
+			Expect(results).To(BeEmpty())
+		} else {
+			Expect(results).To(ContainElement(MatchRegexp(messageRE)))
+		}
+	},
+		Entry("should pass with valid tests",
+			[]obs.DropTest{{DropConditions: []obs.DropCondition{{Field: ".level", Matches: "error"}}}}, ""),
+		Entry("should fail without tests", nil, `route "errors" must have at least one test`),
+		Entry("should fail with an invalid field",
+			[]obs.DropTest{{DropConditions: []obs.DropCondition{{Field: "level", Matches: "error"}}}}, `route "errors": test\[0\].+must start with a '.'`),
+		Entry("should fail with an invalid regex",
+			[]obs.DropTest{{DropConditions: []obs.DropCondition{{Field: ".level", Matches: "(error"}}}}, `must be a valid regular expression`),
+	)
+})
+
+var _ = Describe("Pipeline validation #verifyKubernetesAttributesNotForwardedToLokiStack", func() {
+
+	var (
+		otlpReceiver = func(mapKubernetesAttributes bool) obs.InputSpec {
+			return obs.InputSpec{
+				Name: "my-otlp",
+				Type: obs.InputTypeReceiver,
+				Receiver: &obs.ReceiverSpec{
+					Type: obs.ReceiverTypeOTLP,
+					Port: 4318,
+					OTLP: &obs.OTLPReceiver{MapKubernetesAttributes: mapKubernetesAttributes},
+				},
+			}
+		}
+		outputMap = map[string]obs.OutputSpec{
+			"my-lokistack": {Name: "my-lokistack", Type: obs.OutputTypeLokiStack},
+			"my-http":      {Name: "my-http", Type: obs.OutputTypeHTTP},
+		}
+	)
+
+	DescribeTable("should", func(mapKubernetesAttributes bool, output, messageRE string) {
+		pipelineSpec := obs.PipelineSpec{Name: "myPipeline", InputRefs: []string{"my-otlp"}, OutputRefs: []string{output}}
+		inputMap := map[string]obs.InputSpec{"my-otlp": otlpReceiver(mapKubernetesAttributes)}
+		results := verifyKubernetesAttributesNotForwardedToLokiStack(pipelineSpec, inputMap, outputMap)
+		if messageRE == "" {
+			Expect(results).To(BeEmpty())
+		} else {
+			Expect(results).To(ConsistOf(MatchRegexp(messageRE)))
+		}
+	},
+		Entry("fail when the mapped attributes are forwarded to a LokiStack output", true, "my-lokistack", `input "my-otlp" maps the kubernetes attributes .* output "my-lokistack"`),
+		Entry("pass when the mapped attributes are forwarded to other outputs", true, "my-http", ""),
+		Entry("pass when the attributes are not mapped", false, "my-lokistack", ""),
+	)
 })
@@ -144,6 +144,13 @@ func gatherPipelineInputs(clf obs.ClusterLogForwarder) (sets.String, bool) {
 				if input.Receiver.Type == obs.ReceiverTypeSyslog {
 					inputTypes.Insert(string(obs.InputTypeInfrastructure))
 				}
+				if internalobs.IsApplicationReceiver(input) {
+					inputTypes.Insert(string(obs.InputTypeApplication))
+				}
+			case obs.InputTypeKafka:
+				inputTypes.Insert(string(obs.InputTypeApplication))
+			case obs.InputTypeHostFile, obs.InputTypeEvents:
+				inputTypes.Insert(string(obs.InputTypeInfrastructure))
 			}
 		}
 	}
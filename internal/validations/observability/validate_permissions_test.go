@@ -238,6 +238,41 @@ var _ = Describe("[internal][validations] validate clusterlogforwarder permissio
 			Expect(customClf.Status.Conditions).To(HaveCondition(obs.ConditionTypeAuthorized, true, obs.ReasonClusterRolesExist, ""))
 		})
 
+		It("should return validation error if service account cannot collect infrastructure logs and there is an events input", func() {
+			k8sAuditClient := &mockAuditSARClient{
+				fake.NewFakeClient(clfServiceAccount),
+			}
+
+			const eventsInputName = `kube-events`
+			customClf.Spec = obs.ClusterLogForwarderSpec{
+				ServiceAccount: obs.ServiceAccount{
+					Name: clfServiceAccount.Name,
+				},
+				Inputs: []obs.InputSpec{
+					{
+						Name:   eventsInputName,
+						Type:   obs.InputTypeEvents,
+						Events: &obs.EventsInput{},
+					},
+				},
+
+				Pipelines: []obs.PipelineSpec{
+					{
+						Name: "pipeline1",
+						InputRefs: []string{
+							eventsInputName,
+						},
+					},
+				},
+			}
+			ValidatePermissions(internalcontext.ForwarderContext{
+				Client:    k8sAuditClient,
+				Reader:    k8sAuditClient,
+				Forwarder: &customClf,
+			})
+			Expect(customClf.Status.Conditions).To(HaveCondition(obs.ConditionTypeAuthorized, false, obs.ReasonClusterRoleMissing, "infrastructure"))
+		})
+
 		It("should pass validation if service account can collect external logs and there is a Syslog receiver", func() {
 			const syslogInputName = `syslog-receiver`
 			customClf.Spec = obs.ClusterLogForwarderSpec{
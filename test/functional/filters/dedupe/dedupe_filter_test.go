@@ -0,0 +1,104 @@
+package dedupe
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+)
+
+var _ = Describe("[Functional][Filters][Dedupe] Dedupe filter", func() {
+	const (
+		dedupeFilterName = "my-dedupe"
+	)
+
+	var (
+		f *functional.CollectorFunctionalFramework
+	)
+
+	AfterEach(func() {
+		f.Cleanup()
+	})
+
+	Describe("when dedupe filter is spec'd", func() {
+		It("should forward the first of the duplicates with their count", func() {
+			f = functional.NewCollectorFunctionalFramework()
+
+			testruntime.NewClusterLogForwarderBuilder(f.Forwarder).
+				FromInput(obs.InputTypeApplication).
+				WithFilter(dedupeFilterName, func(spec *obs.FilterSpec) {
+					spec.Type = obs.FilterTypeDedupe
+					spec.DedupeFilterSpec = &obs.DedupeFilterSpec{
+						Fields:        []obs.FieldPath{".kubernetes.namespace_name", ".message"},
+						WindowSeconds: 5,
+					}
+				}).
+				ToElasticSearchOutput()
+
+			Expect(f.Deploy()).To(BeNil())
+			msg := functional.NewFullCRIOLogMessage(functional.CRIOTime(time.Now()), "my duplicate message")
+			Expect(f.WriteMessagesToApplicationLog(msg, 10)).To(BeNil())
+			msg = functional.NewFullCRIOLogMessage(functional.CRIOTime(time.Now()), "my unique message")
+			Expect(f.WriteMessagesToApplicationLog(msg, 1)).To(BeNil())
+
+			var raw []string
+			Eventually(func() int {
+				var err error
+				raw, err = f.ReadRawApplicationLogsFrom(string(obs.OutputTypeElasticsearch))
+				Expect(err).To(BeNil(), "Error fetching logs from %s: %v", obs.OutputTypeElasticsearch, err)
+				return len(raw)
+			}, 30*time.Second, 2*time.Second).Should(Equal(2))
+
+			counts := map[string]interface{}{}
+			for _, r := range raw {
+				record := map[string]interface{}{}
+				Expect(json.Unmarshal([]byte(r), &record)).To(Succeed())
+				Expect(record["@timestamp"]).ToNot(BeEmpty())
+				Expect(record).ToNot(HaveKey("dedupe_key"))
+				Expect(record).ToNot(HaveKey("dedupe_count"))
+				Expect(record).ToNot(HaveKey("dedupe_record"))
+				counts[record["message"].(string)] = record["dedupe_suppressed_count"]
+			}
+			Expect(counts).To(HaveKeyWithValue("my duplicate message", float64(9)))
+			Expect(counts).To(HaveKeyWithValue("my unique message", BeNil()))
+		})
+
+		It("should forward the records without any of the fields unchanged", func() {
+			f = functional.NewCollectorFunctionalFramework()
+
+			testruntime.NewClusterLogForwarderBuilder(f.Forwarder).
+				FromInput(obs.InputTypeApplication).
+				WithFilter(dedupeFilterName, func(spec *obs.FilterSpec) {
+					spec.Type = obs.FilterTypeDedupe
+					spec.DedupeFilterSpec = &obs.DedupeFilterSpec{
+						Fields:        []obs.FieldPath{".structured.request_id"},
+						WindowSeconds: 5,
+					}
+				}).
+				ToElasticSearchOutput()
+
+			Expect(f.Deploy()).To(BeNil())
+			msg := functional.NewFullCRIOLogMessage(functional.CRIOTime(time.Now()), "my message without a request id")
+			Expect(f.WriteMessagesToApplicationLog(msg, 5)).To(BeNil())
+
+			var raw []string
+			Eventually(func() int {
+				var err error
+				raw, err = f.ReadRawApplicationLogsFrom(string(obs.OutputTypeElasticsearch))
+				Expect(err).To(BeNil(), "Error fetching logs from %s: %v", obs.OutputTypeElasticsearch, err)
+				return len(raw)
+			}, 30*time.Second, 2*time.Second).Should(Equal(5))
+
+			for _, r := range raw {
+				record := map[string]interface{}{}
+				Expect(json.Unmarshal([]byte(r), &record)).To(Succeed())
+				Expect(record["message"]).To(Equal("my message without a request id"))
+				Expect(record).ToNot(HaveKey("dedupe_suppressed_count"))
+			}
+		})
+	})
+})
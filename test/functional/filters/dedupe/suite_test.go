@@ -0,0 +1,13 @@
+package dedupe
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestFiltersDedupe(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "[functional][filters][dedupe]")
+}
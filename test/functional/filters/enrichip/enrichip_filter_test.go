@@ -0,0 +1,71 @@
+package enrichip
+
+import (
+	"encoding/json"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+)
+
+var _ = Describe("[Functional][Filters][EnrichIP] EnrichIP filter", func() {
+	const (
+		parseFilterName    = "my-parse"
+		enrichIPFilterName = "my-zones"
+		tableName          = "network-zones"
+		tableKey           = "zones.csv"
+		timestamp          = "2020-11-04T18:13:59.061892+00:00"
+	)
+
+	var (
+		f *functional.CollectorFunctionalFramework
+	)
+
+	AfterEach(func() {
+		f.Cleanup()
+	})
+
+	DescribeTable("should label the record with the most specific CIDR containing an address", func(message string, exp interface{}) {
+		f = functional.NewCollectorFunctionalFramework()
+		f.AddSecret(runtime.NewSecret("", tableName, map[string][]byte{
+			tableKey: []byte("cidr,label\n10.0.0.0/8,datacenter\n10.0.0.0/16,datacenter-east\nfd00::/8,local\n"),
+		}))
+
+		testruntime.NewClusterLogForwarderBuilder(f.Forwarder).
+			FromInput(obs.InputTypeApplication).
+			WithFilter(parseFilterName, func(spec *obs.FilterSpec) {
+				spec.Type = obs.FilterTypeParse
+				spec.ParseFilterSpec = &obs.ParseFilterSpec{}
+			}).
+			WithFilter(enrichIPFilterName, func(spec *obs.FilterSpec) {
+				spec.Type = obs.FilterTypeEnrichIP
+				spec.EnrichIPFilterSpec = &obs.EnrichIPFilterSpec{
+					Fields: []obs.FieldPath{".structured.sourceIPs"},
+					CIDRTable: obs.ValueReference{
+						Key:        tableKey,
+						SecretName: tableName,
+					},
+				}
+			}).
+			ToHttpOutput()
+		Expect(f.Deploy()).To(BeNil())
+
+		Expect(f.WriteMessagesToApplicationLog(fmt.Sprintf("%s stdout F %s", timestamp, message), 1)).To(BeNil())
+
+		raw, err := f.ReadRawApplicationLogsFrom(string(obs.OutputTypeHTTP))
+		Expect(err).To(BeNil(), "Expected no errors reading the logs")
+		Expect(raw).To(HaveLen(1))
+		record := map[string]interface{}{}
+		Expect(json.Unmarshal([]byte(raw[0]), &record)).To(Succeed())
+		Expect(record["network_zone"]).To(Equal(exp))
+	},
+		Entry("with the most specific CIDR listed last", `{"sourceIPs":["10.0.1.5"]}`, "datacenter-east"),
+		Entry("with an IPv6 address", `{"sourceIPs":["fd00:1::5"]}`, "local"),
+		Entry("with the first address outside of any CIDR", `{"sourceIPs":["192.168.1.1","10.5.0.1"]}`, "datacenter"),
+		Entry("with no address in a CIDR", `{"sourceIPs":["192.168.1.1"]}`, nil),
+	)
+})
@@ -0,0 +1,53 @@
+package kubernetesmetadata
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+)
+
+var _ = Describe("[Functional][Filters][KubernetesMetadata] KubernetesMetadata filter", func() {
+	const (
+		kubernetesMetadataFilterName = "my-metadata"
+	)
+
+	var (
+		f *functional.CollectorFunctionalFramework
+	)
+
+	AfterEach(func() {
+		f.Cleanup()
+	})
+
+	It("should keep only the allowed pod annotations with dedotted keys", func() {
+		f = functional.NewCollectorFunctionalFramework()
+
+		testruntime.NewClusterLogForwarderBuilder(f.Forwarder).
+			FromInput(obs.InputTypeApplication).
+			WithFilter(kubernetesMetadataFilterName, func(spec *obs.FilterSpec) {
+				spec.Type = obs.FilterTypeKubernetesMetadata
+				spec.KubernetesMetadataFilterSpec = &obs.KubernetesMetadataFilterSpec{
+					Annotations: []string{"app.example.com/team"},
+				}
+			}).
+			ToHttpOutput()
+
+		Expect(f.DeployWithVisitor(func(b *runtime.PodBuilder) error {
+			b.AddAnnotation("app.example.com/team", "payments").
+				AddAnnotation("app.example.com/secret-note", "do not forward")
+			return nil
+		})).To(BeNil())
+		msg := functional.NewFullCRIOLogMessage(functional.CRIOTime(time.Now()), "my message")
+		Expect(f.WriteMessagesToApplicationLog(msg, 1)).To(BeNil())
+
+		logs, err := f.ReadApplicationLogsFrom(string(obs.OutputTypeHTTP))
+		Expect(err).To(BeNil(), "Error fetching logs from %s: %v", obs.OutputTypeHTTP, err)
+		Expect(logs).To(HaveLen(1))
+		Expect(logs[0].Kubernetes.Annotations).To(Equal(map[string]string{"app_example_com_team": "payments"}))
+	})
+})
@@ -0,0 +1,13 @@
+package kubernetesmetadata
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestFiltersKubernetesMetadata(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "[functional][filters][kubernetesmetadata]")
+}
@@ -0,0 +1,79 @@
+package logtometric
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+)
+
+var _ = Describe("[Functional][Filters][LogToMetric] LogToMetric filter", func() {
+	const (
+		logToMetricFilterName = "my-metrics"
+	)
+
+	var (
+		f *functional.CollectorFunctionalFramework
+	)
+
+	BeforeEach(func() {
+		f = functional.NewCollectorFunctionalFramework()
+		role, binding, tokenBinding, err := f.SetupMetricsRBAC()
+		Expect(err).To(Succeed())
+		DeferCleanup(func() {
+			_ = f.Test.Delete(tokenBinding)
+			_ = f.Test.Delete(binding)
+			_ = f.Test.Delete(role)
+		})
+	})
+
+	AfterEach(func() {
+		f.Cleanup()
+	})
+
+	Describe("when logToMetric filter is spec'd", func() {
+		It("should publish the metrics and forward the records unmodified", func() {
+			testruntime.NewClusterLogForwarderBuilder(f.Forwarder).
+				FromInput(obs.InputTypeApplication).
+				WithFilter(logToMetricFilterName, func(spec *obs.FilterSpec) {
+					spec.Type = obs.FilterTypeLogToMetric
+					spec.LogToMetricFilterSpec = &obs.LogToMetricFilterSpec{
+						Metrics: []obs.LogMetric{
+							{
+								Name: "errors_total",
+								Type: obs.LogMetricTypeCounter,
+								Tests: []obs.DropTest{
+									{
+										DropConditions: []obs.DropCondition{
+											{Field: ".message", Matches: "error"},
+										},
+									},
+								},
+								Tags: map[string]obs.FieldPath{
+									"namespace": ".kubernetes.namespace_name",
+								},
+							},
+						},
+					}
+				}).
+				ToElasticSearchOutput()
+
+			Expect(f.Deploy()).To(BeNil())
+			msg := functional.NewFullCRIOLogMessage(functional.CRIOTime(time.Now()), "an error occurred")
+			Expect(f.WriteMessagesToApplicationLog(msg, 3)).To(BeNil())
+			msg = functional.NewFullCRIOLogMessage(functional.CRIOTime(time.Now()), "all is well")
+			Expect(f.WriteMessagesToApplicationLog(msg, 2)).To(BeNil())
+
+			logs, err := f.ReadApplicationLogsFrom(string(obs.OutputTypeElasticsearch))
+			Expect(err).To(BeNil(), "Error fetching logs from %s: %v", obs.OutputTypeElasticsearch, err)
+			Expect(logs).To(Not(BeEmpty()), "Exp. logs to be forwarded to %s", obs.OutputTypeElasticsearch)
+			Expect(logs[0].Message).To(Equal("an error occurred"))
+
+			lines, err := f.CollectMetricLines("logcollector_filter_errors_total", "logcollector_filter_errors_total{namespace=\""+f.Namespace+"\"} 3", 60*time.Second)
+			Expect(err).To(BeNil(), "Timed out waiting for logcollector_filter_errors_total: %v", lines)
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package logtometric
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestFiltersLogToMetric(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "[functional][filters][logtometric]")
+}
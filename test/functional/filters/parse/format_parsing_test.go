@@ -0,0 +1,103 @@
+package parse
+
+import (
+	"encoding/json"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+)
+
+var _ = Describe("[functional][filters][parse] Format log parsing", func() {
+	const (
+		parseFilterName = "my-parse"
+		timestamp       = "2020-11-04T18:13:59.061892+00:00"
+	)
+
+	var (
+		framework *functional.CollectorFunctionalFramework
+	)
+
+	AfterEach(func() {
+		framework.Cleanup()
+	})
+
+	DescribeTable("should parse the message into the target field", func(spec obs.ParseFilterSpec, message string, exp map[string]interface{}) {
+		framework = functional.NewCollectorFunctionalFramework()
+		testruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+			FromInput(obs.InputTypeApplication).
+			WithFilter(parseFilterName, func(filter *obs.FilterSpec) {
+				filter.Type = obs.FilterTypeParse
+				filter.ParseFilterSpec = &spec
+			}).
+			ToHttpOutput()
+		Expect(framework.Deploy()).To(BeNil())
+
+		Expect(framework.WriteMessagesToApplicationLog(fmt.Sprintf("%s stdout F %s", timestamp, message), 1)).To(BeNil())
+
+		raw, err := framework.ReadRawApplicationLogsFrom(string(obs.OutputTypeHTTP))
+		Expect(err).To(BeNil(), "Expected no errors reading the logs")
+		Expect(raw).To(HaveLen(1))
+		record := map[string]interface{}{}
+		Expect(json.Unmarshal([]byte(raw[0]), &record)).To(Succeed())
+		Expect(record["message"]).To(Equal(message))
+		Expect(record["parsed"]).To(Equal(exp))
+	},
+		Entry("with logfmt", obs.ParseFilterSpec{
+			Format: obs.ParseFormatLogfmt,
+			Target: ".parsed",
+		}, `level=info msg="request served" status=200`, map[string]interface{}{
+			"level": "info", "msg": "request served", "status": "200",
+		}),
+		Entry("with keyValue", obs.ParseFilterSpec{
+			Format:   obs.ParseFormatKeyValue,
+			Target:   ".parsed",
+			KeyValue: &obs.ParseKeyValueSpec{KeyValueDelimiter: ":", FieldDelimiter: ";"},
+		}, `level:info;user:admin`, map[string]interface{}{
+			"level": "info", "user": "admin",
+		}),
+		Entry("with csv", obs.ParseFilterSpec{
+			Format: obs.ParseFormatCSV,
+			Target: ".parsed",
+			CSV:    &obs.ParseCSVSpec{Headers: []string{"level", "user", "action"}},
+		}, `info,admin,login`, map[string]interface{}{
+			"level": "info", "user": "admin", "action": "login",
+		}),
+		Entry("with regex", obs.ParseFilterSpec{
+			Format: obs.ParseFormatRegex,
+			Target: ".parsed",
+			Regex:  &obs.ParseRegexSpec{Pattern: `^(?P<level>\w+) (?P<msg>.*)$`},
+		}, `WARN disk almost full`, map[string]interface{}{
+			"level": "WARN", "msg": "disk almost full",
+		}),
+	)
+
+	It("should tag records that can not be parsed", func() {
+		framework = functional.NewCollectorFunctionalFramework()
+		testruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+			FromInput(obs.InputTypeApplication).
+			WithFilter(parseFilterName, func(filter *obs.FilterSpec) {
+				filter.Type = obs.FilterTypeParse
+				filter.ParseFilterSpec = &obs.ParseFilterSpec{
+					Format:    obs.ParseFormatCEF,
+					OnFailure: obs.ParseFailurePolicyTag,
+				}
+			}).
+			ToHttpOutput()
+		Expect(framework.Deploy()).To(BeNil())
+
+		Expect(framework.WriteMessagesToApplicationLog(fmt.Sprintf("%s stdout F %s", timestamp, "not a CEF message"), 1)).To(BeNil())
+
+		raw, err := framework.ReadRawApplicationLogsFrom(string(obs.OutputTypeHTTP))
+		Expect(err).To(BeNil(), "Expected no errors reading the logs")
+		Expect(raw).To(HaveLen(1))
+		record := map[string]interface{}{}
+		Expect(json.Unmarshal([]byte(raw[0]), &record)).To(Succeed())
+		Expect(record["message"]).To(Equal("not a CEF message"))
+		Expect(record).To(HaveKey("parse_error"))
+		Expect(record).ToNot(HaveKey("structured"))
+	})
+})
@@ -0,0 +1,62 @@
+package redact
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+)
+
+var _ = Describe("[Functional][Filters][Redact] Redact filter", func() {
+	const (
+		redactFilterName = "my-redact"
+		message          = "user jdoe@example.com paid with 4111 1111 1111 1111 using Bearer abc.def-123"
+	)
+
+	var (
+		f *functional.CollectorFunctionalFramework
+	)
+
+	AfterEach(func() {
+		f.Cleanup()
+	})
+
+	DescribeTable("when redact filter is spec'd", func(replacement *obs.RedactReplacement, expMessage string) {
+		f = functional.NewCollectorFunctionalFramework()
+
+		testruntime.NewClusterLogForwarderBuilder(f.Forwarder).
+			FromInput(obs.InputTypeApplication).
+			WithFilter(redactFilterName, func(spec *obs.FilterSpec) {
+				spec.Type = obs.FilterTypeRedact
+				spec.RedactFilterSpec = &obs.RedactFilterSpec{
+					Fields: []obs.FieldPath{".message"},
+					Patterns: []obs.RedactPatternType{
+						obs.RedactPatternEmail,
+						obs.RedactPatternCreditCard,
+						obs.RedactPatternBearerToken,
+					},
+					Replacement: replacement,
+				}
+			}).
+			ToElasticSearchOutput()
+
+		Expect(f.Deploy()).To(BeNil())
+		msg := functional.NewFullCRIOLogMessage(functional.CRIOTime(time.Now()), message)
+		Expect(f.WriteMessagesToApplicationLog(msg, 1)).To(BeNil())
+
+		logs, err := f.ReadApplicationLogsFrom(string(obs.OutputTypeElasticsearch))
+		Expect(err).To(BeNil(), "Error fetching logs from %s: %v", obs.OutputTypeElasticsearch, err)
+		Expect(logs).To(Not(BeEmpty()), "Exp. logs to be forwarded to %s", obs.OutputTypeElasticsearch)
+		Expect(logs[0].Message).To(Equal(expMessage))
+	},
+		Entry("should mask matches with the default mask", nil,
+			"user [REDACTED] paid with [REDACTED] using [REDACTED]"),
+		Entry("should mask matches with a custom mask", &obs.RedactReplacement{Mask: "***"},
+			"user *** paid with *** using ***"),
+		Entry("should partially mask matches", &obs.RedactReplacement{Strategy: obs.RedactStrategyPartial},
+			"user ************.com paid with ***************1111 using **************-123"),
+	)
+})
@@ -0,0 +1,119 @@
+package sample
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+)
+
+var _ = Describe("[Functional][Filters][Sample] Sample filter", func() {
+	const (
+		sampleFilterName = "my-sample"
+		numOfLogs        = 5
+	)
+
+	var (
+		f *functional.CollectorFunctionalFramework
+	)
+
+	AfterEach(func() {
+		f.Cleanup()
+	})
+
+	Describe("when sample filter is spec'd", func() {
+		It("should sample records while keeping all excluded records", func() {
+			f = functional.NewCollectorFunctionalFramework()
+
+			testruntime.NewClusterLogForwarderBuilder(f.Forwarder).
+				FromInput(obs.InputTypeApplication).
+				WithFilter(sampleFilterName, func(spec *obs.FilterSpec) {
+					spec.Type = obs.FilterTypeSample
+					spec.SampleFilterSpec = &obs.SampleFilterSpec{
+						Rate: utils.GetPtr[int64](1000000),
+						Exclude: []obs.DropTest{
+							{
+								DropConditions: []obs.DropCondition{
+									{Field: ".message", Matches: "keep-me"},
+								},
+							},
+						},
+					}
+				}).
+				ToElasticSearchOutput()
+
+			Expect(f.Deploy()).To(BeNil())
+			msg := functional.NewFullCRIOLogMessage(functional.CRIOTime(time.Now()), "drop-me")
+			Expect(f.WriteMessagesToApplicationLog(msg, numOfLogs)).To(BeNil())
+			msg = functional.NewFullCRIOLogMessage(functional.CRIOTime(time.Now()), "keep-me")
+			Expect(f.WriteMessagesToApplicationLog(msg, numOfLogs)).To(BeNil())
+
+			var kept, sampled int
+			Eventually(func() int {
+				logs, err := f.ReadApplicationLogsFrom(string(obs.OutputTypeElasticsearch))
+				Expect(err).To(BeNil(), "Error fetching logs from %s: %v", obs.OutputTypeElasticsearch, err)
+				kept, sampled = 0, 0
+				for _, log := range logs {
+					if strings.Contains(log.Message, "keep-me") {
+						kept++
+					} else {
+						sampled++
+					}
+				}
+				return kept
+			}, 2*time.Minute, 5*time.Second).Should(Equal(numOfLogs))
+			Expect(sampled).To(BeNumerically("<=", 1), "Exp. all but at most one record to be sampled out")
+		})
+
+		It("should make the same decision for all records with the same key", func() {
+			const numOfKeys = 10
+			f = functional.NewCollectorFunctionalFramework()
+
+			testruntime.NewClusterLogForwarderBuilder(f.Forwarder).
+				FromInput(obs.InputTypeApplication).
+				WithFilter(sampleFilterName, func(spec *obs.FilterSpec) {
+					spec.Type = obs.FilterTypeSample
+					spec.SampleFilterSpec = &obs.SampleFilterSpec{
+						Percent:  utils.GetPtr[int32](50),
+						KeyField: ".message",
+						Exclude: []obs.DropTest{
+							{
+								DropConditions: []obs.DropCondition{
+									{Field: ".message", Matches: "^done$"},
+								},
+							},
+						},
+					}
+				}).
+				ToElasticSearchOutput()
+
+			Expect(f.Deploy()).To(BeNil())
+			for i := 0; i < numOfKeys; i++ {
+				msg := functional.NewFullCRIOLogMessage(functional.CRIOTime(time.Now()), fmt.Sprintf("key-%d", i))
+				Expect(f.WriteMessagesToApplicationLog(msg, numOfLogs)).To(BeNil())
+			}
+			msg := functional.NewFullCRIOLogMessage(functional.CRIOTime(time.Now()), "done")
+			Expect(f.WriteMessagesToApplicationLog(msg, 1)).To(BeNil())
+
+			perKey := map[string]int{}
+			Eventually(func() int {
+				logs, err := f.ReadApplicationLogsFrom(string(obs.OutputTypeElasticsearch))
+				Expect(err).To(BeNil(), "Error fetching logs from %s: %v", obs.OutputTypeElasticsearch, err)
+				perKey = map[string]int{}
+				for _, log := range logs {
+					perKey[log.Message]++
+				}
+				return perKey["done"]
+			}, 2*time.Minute, 5*time.Second).Should(Equal(1))
+			for i := 0; i < numOfKeys; i++ {
+				Expect(perKey[fmt.Sprintf("key-%d", i)]).To(Or(Equal(0), Equal(numOfLogs)), "Exp. all or none of the records of key-%d to be kept", i)
+			}
+		})
+	})
+})
@@ -0,0 +1,57 @@
+package throttle
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+)
+
+var _ = Describe("[Functional][Filters][Throttle] Throttle filter", func() {
+	const (
+		throttleFilterName = "my-throttle"
+		threshold          = 2
+	)
+
+	var (
+		f *functional.CollectorFunctionalFramework
+	)
+
+	AfterEach(func() {
+		f.Cleanup()
+	})
+
+	Describe("when throttle filter is spec'd", func() {
+		It("should limit the records of each namespace to the threshold per window", func() {
+			f = functional.NewCollectorFunctionalFramework()
+
+			testruntime.NewClusterLogForwarderBuilder(f.Forwarder).
+				FromInput(obs.InputTypeApplication).
+				WithFilter(throttleFilterName, func(spec *obs.FilterSpec) {
+					spec.Type = obs.FilterTypeThrottle
+					spec.ThrottleFilterSpec = &obs.ThrottleFilterSpec{
+						Key:           "{.kubernetes.namespace_name}",
+						Threshold:     threshold,
+						WindowSeconds: 300,
+					}
+				}).
+				ToElasticSearchOutput()
+
+			Expect(f.Deploy()).To(BeNil())
+			msg := functional.NewFullCRIOLogMessage(functional.CRIOTime(time.Now()), "my message")
+			Expect(f.WriteMessagesToApplicationLog(msg, 10)).To(BeNil())
+
+			logs, err := f.ReadApplicationLogsFrom(string(obs.OutputTypeElasticsearch))
+			Expect(err).To(BeNil(), "Error fetching logs from %s: %v", obs.OutputTypeElasticsearch, err)
+			Expect(logs).To(Not(BeEmpty()), "Exp. logs to be forwarded to %s", obs.OutputTypeElasticsearch)
+			Consistently(func() int {
+				logs, err = f.ReadApplicationLogsFrom(string(obs.OutputTypeElasticsearch))
+				Expect(err).To(BeNil(), "Error fetching logs from %s: %v", obs.OutputTypeElasticsearch, err)
+				return len(logs)
+			}, 20*time.Second, 5*time.Second).Should(BeNumerically("<=", threshold))
+		})
+	})
+})
@@ -0,0 +1,57 @@
+package transform
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+)
+
+var _ = Describe("[Functional][Filters][Transform] Transform filter", func() {
+	const (
+		transformFilterName = "my-transform"
+	)
+
+	var (
+		f *functional.CollectorFunctionalFramework
+	)
+
+	AfterEach(func() {
+		f.Cleanup()
+	})
+
+	Describe("when transform filter is spec'd", func() {
+		It("should apply the VRL program to each record", func() {
+			f = functional.NewCollectorFunctionalFramework()
+
+			testruntime.NewClusterLogForwarderBuilder(f.Forwarder).
+				FromInput(obs.InputTypeApplication).
+				WithFilter(transformFilterName, func(spec *obs.FilterSpec) {
+					spec.Type = obs.FilterTypeTransform
+					spec.TransformFilterSpec = &obs.TransformFilterSpec{
+						Source: `
+.message = upcase(string!(.message))
+.level = "critical"
+`,
+					}
+				}).
+				ToElasticSearchOutput()
+
+			Expect(f.Deploy()).To(BeNil())
+			msg := functional.NewFullCRIOLogMessage(functional.CRIOTime(time.Now()), "my error message")
+			Expect(f.WriteMessagesToApplicationLog(msg, 1)).To(BeNil())
+
+			logs, err := f.ReadApplicationLogsFrom(string(obs.OutputTypeElasticsearch))
+			Expect(err).To(BeNil(), "Error fetching logs from %s: %v", obs.OutputTypeElasticsearch, err)
+			Expect(logs).To(Not(BeEmpty()), "Exp. logs to be forwarded to %s", obs.OutputTypeElasticsearch)
+
+			log := logs[0]
+			Expect(log.Message).To(Equal("MY ERROR MESSAGE"))
+			Expect(log.Level).To(Equal("critical"))
+			Expect(log.Kubernetes.NamespaceName).ToNot(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,107 @@
+package http
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+)
+
+var _ = Describe("[Functional][Inputs][Http] Application log formats", func() {
+
+	const appInputName = "http-app-source"
+
+	var (
+		framework *functional.CollectorFunctionalFramework
+	)
+
+	deploy := func(format obs.HTTPReceiverFormat, logSource string) {
+		framework = functional.NewCollectorFunctionalFramework()
+		framework.VisitConfig = func(conf string) string {
+			return strings.Replace(conf, "enabled = true", "enabled = false", 2) // turn off TLS for testing
+		}
+		testruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+			FromInputName(appInputName,
+				func(spec *obs.InputSpec) {
+					spec.Type = obs.InputTypeReceiver
+					spec.Receiver = &obs.ReceiverSpec{
+						Port: servicePortNum,
+						Type: obs.ReceiverTypeHTTP,
+						HTTP: &obs.HTTPReceiver{
+							Format:    format,
+							LogSource: logSource,
+						},
+					}
+				}).ToHttpOutput()
+		Expect(framework.DeployWithVisitor(
+			func(b *runtime.PodBuilder) error {
+				return framework.AddVectorHttpOutput(b, framework.Forwarder.Spec.Outputs[0])
+			}),
+		).To(BeNil())
+	}
+
+	readRecords := func(exp int) []map[string]interface{} {
+		raw, err := framework.ReadFileFromWithRetryInterval(string(obs.OutputTypeHTTP), functional.ApplicationLogFile, time.Second)
+		Expect(err).To(BeNil(), "Expected no errors reading the logs")
+		lines := strings.Split(strings.TrimSpace(raw), "\n")
+		Expect(lines).To(HaveLen(exp), "--- raw lines:\n%v\n...", raw)
+		records := []map[string]interface{}{}
+		for _, line := range lines {
+			record := map[string]interface{}{}
+			Expect(json.Unmarshal([]byte(line), &record)).To(Succeed())
+			records = append(records, record)
+		}
+		return records
+	}
+
+	AfterEach(func() {
+		framework.Cleanup()
+	})
+
+	It("should normalize an array of JSON records into application logs", func() {
+		deploy(obs.HTTPReceiverFormatJSON, "")
+		Expect(framework.WriteToHttpInputWithPortForwarder(appInputName,
+			[]byte(`[{"message":"hello","level":"WARN","user":"alice"},{"message":"world","user":"bob"}]`))).To(Succeed())
+		records := readRecords(2)
+		Expect(records[0]).To(HaveKeyWithValue("log_type", string(obs.InputTypeApplication)))
+		Expect(records[0]).To(HaveKeyWithValue("log_source", "http"))
+		Expect(records[0]).To(HaveKeyWithValue("message", "hello"))
+		Expect(records[0]).To(HaveKeyWithValue("level", "warn"))
+		Expect(records[0]).To(HaveKeyWithValue("structured", HaveKeyWithValue("user", "alice")))
+		Expect(records[1]).To(HaveKeyWithValue("message", "world"))
+		for _, record := range records {
+			Expect(record).To(HaveKeyWithValue("structured", Not(HaveKey("path"))))
+			Expect(record).To(HaveKeyWithValue("structured", Not(HaveKey("source_type"))))
+		}
+	})
+
+	It("should normalize newline delimited JSON records with the configured log source", func() {
+		deploy(obs.HTTPReceiverFormatNDJSON, "myagent")
+		Expect(framework.WriteToHttpInputWithPortForwarder(appInputName,
+			[]byte("{\"message\":\"one\"}\n{\"message\":\"two\"}\n"))).To(Succeed())
+		records := readRecords(2)
+		for _, record := range records {
+			Expect(record).To(HaveKeyWithValue("log_type", string(obs.InputTypeApplication)))
+			Expect(record).To(HaveKeyWithValue("log_source", "myagent"))
+		}
+		Expect(records[1]).To(HaveKeyWithValue("message", "two"))
+	})
+
+	It("should normalize each line of text into an application log", func() {
+		deploy(obs.HTTPReceiverFormatText, "")
+		Expect(framework.WriteToHttpInputWithPortForwarder(appInputName,
+			[]byte("level=error msg=\"first line\"\nsecond line\n"))).To(Succeed())
+		records := readRecords(2)
+		Expect(records[0]).To(HaveKeyWithValue("message", `level=error msg="first line"`))
+		Expect(records[0]).To(HaveKeyWithValue("level", "error"))
+		Expect(records[1]).To(HaveKeyWithValue("message", "second line"))
+		Expect(records[1]).To(HaveKeyWithValue("log_source", "http"))
+		Expect(records[1]).ToNot(HaveKey("structured"))
+	})
+})
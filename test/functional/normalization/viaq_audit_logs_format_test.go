@@ -132,6 +132,7 @@ var _ = Describe("[Functional][LogForwarding][Normalization] message format test
 				AuditLinux: types.AuditLinux{
 					Type:     "DAEMON_START",
 					RecordID: "*",
+					UID:      "0",
 				},
 				Timestamp:        testTime,
 				TimestampLegacy:  testTime,
@@ -178,6 +179,10 @@ var _ = Describe("[Functional][LogForwarding][Normalization] message format test
 					Sequence:  types.NewOptionalInt(""),
 					ClusterID: "*",
 				},
+				OVN: types.OVNACL{
+					Name:    "verify-audit-logging_deny-all",
+					Verdict: "drop",
+				},
 			}
 
 			Expect(framework.WriteMessagesToOVNAuditLog(ovnLogLine, 10)).To(BeNil())
@@ -0,0 +1,66 @@
+package pipelines
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+)
+
+var _ = Describe("[Functional][Pipelines] when a pipeline has routes", func() {
+
+	var (
+		framework *functional.CollectorFunctionalFramework
+	)
+
+	AfterEach(func() {
+		framework.Cleanup()
+	})
+
+	It("should send the records matching a route to the outputs of the route and the others to the pipeline outputs", func() {
+		framework = functional.NewCollectorFunctionalFramework()
+		testruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+			FromInput(obs.InputTypeApplication).
+			ToHttpOutput().
+			FromInput(obs.InputTypeApplication).
+			ToElasticSearchOutput()
+		pipeline := &framework.Forwarder.Spec.Pipelines[0]
+		pipeline.OutputRefs = []string{string(obs.OutputTypeHTTP)}
+		pipeline.Routes = []obs.PipelineRoute{
+			{
+				Name: "errors",
+				Tests: []obs.DropTest{
+					{
+						DropConditions: []obs.DropCondition{
+							{Field: ".message", Matches: "^error"},
+						},
+					},
+				},
+				OutputRefs: []string{string(obs.OutputTypeElasticsearch)},
+			},
+		}
+		Expect(framework.Deploy()).To(BeNil())
+
+		timestamp := functional.CRIOTime(time.Now())
+		Expect(framework.WriteMessagesToApplicationLog(fmt.Sprintf("%s stdout F %s", timestamp, "error: disk full"), 2)).To(BeNil())
+		Expect(framework.WriteMessagesToApplicationLog(fmt.Sprintf("%s stdout F %s", timestamp, "all is well"), 3)).To(BeNil())
+
+		routed, err := framework.ReadApplicationLogsFrom(string(obs.OutputTypeElasticsearch))
+		Expect(err).To(BeNil(), "Exp. no errors reading the routed logs")
+		Expect(routed).To(HaveLen(2), "Exp. only the error logs to be routed")
+		for _, l := range routed {
+			Expect(l.Message).To(Equal("error: disk full"))
+		}
+
+		unmatched, err := framework.ReadApplicationLogsFrom(string(obs.OutputTypeHTTP))
+		Expect(err).To(BeNil(), "Exp. no errors reading the unmatched logs")
+		Expect(unmatched).To(HaveLen(3), "Exp. only the unmatched logs to be sent to the pipeline outputs")
+		for _, l := range unmatched {
+			Expect(l.Message).To(Equal("all is well"))
+		}
+	})
+})
@@ -333,6 +333,9 @@ type LinuxAuditLog struct {
 type AuditLinux struct {
 	Type     string `json:"type,omitempty"`
 	RecordID string `json:"record_id,omitempty"`
+	Syscall  string `json:"syscall,omitempty"`
+	Exe      string `json:"exe,omitempty"`
+	UID      string `json:"uid,omitempty"`
 }
 
 // OVN Audit log
@@ -347,6 +350,20 @@ type OVNAuditLog struct {
 	ViaqMsgID       string        `json:"viaq_msg_id,omitempty"`
 	Openshift       OpenshiftMeta `json:"openshift,omitempty"`
 	Level           string        `json:"level,omitempty"`
+	OVN             OVNACL        `json:"ovn,omitempty"`
+}
+
+// OVNACL is the ACL and flow parsed from an OVN audit log
+type OVNACL struct {
+	Name      string `json:"name,omitempty"`
+	Verdict   string `json:"verdict,omitempty"`
+	Severity  string `json:"severity,omitempty"`
+	Direction string `json:"direction,omitempty"`
+	Protocol  string `json:"protocol,omitempty"`
+	Src       string `json:"src,omitempty"`
+	Dst       string `json:"dst,omitempty"`
+	SrcPort   string `json:"src_port,omitempty"`
+	DstPort   string `json:"dst_port,omitempty"`
 }
 
 // AuditLogCommon is common to k8s and openshift auditlogs